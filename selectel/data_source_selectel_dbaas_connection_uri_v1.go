@@ -0,0 +1,138 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDBaaSConnectionURIV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDBaaSConnectionURIV1Read,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ru1Region,
+					ru2Region,
+					ru3Region,
+					ru7Region,
+					ru8Region,
+					ru9Region,
+					uz1Region,
+				}, false),
+			},
+			"datastore_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  dbaasConnectionURIFormatURI,
+				ValidateFunc: validation.StringInSlice([]string{
+					dbaasConnectionURIFormatURI,
+					dbaasConnectionURIFormatJDBC,
+				}, false),
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"ssl_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ssl_root_cert": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"master_uri": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"connection_uris": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDBaaSConnectionURIV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dbaasClient, diagErr := getDBaaSClient(ctx, d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	datastoreID := d.Get("datastore_id").(string)
+	datastore, err := dbaasClient.Datastore(ctx, datastoreID)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectDatastore, datastoreID, err))
+	}
+
+	datastoreType, err := dbaasClient.DatastoreType(ctx, datastore.TypeID)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectDatastoreTypes, datastore.TypeID, err))
+	}
+	if _, ok := dbaasDefaultPorts[datastoreType.Engine]; !ok {
+		return diag.FromErr(fmt.Errorf("connection URIs are only supported for postgresql and mysql datastores, got %s", datastoreType.Engine))
+	}
+
+	opts := dbaasConnectionURIOpts{
+		engine:      datastoreType.Engine,
+		format:      d.Get("format").(string),
+		port:        d.Get("port").(int),
+		user:        d.Get("user").(string),
+		password:    d.Get("password").(string),
+		database:    d.Get("database").(string),
+		sslMode:     d.Get("ssl_mode").(string),
+		sslRootCert: d.Get("ssl_root_cert").(string),
+	}
+	uris := buildDBaaSConnectionURIs(datastore.Connection, opts)
+
+	d.Set("engine", datastoreType.Engine)
+	d.Set("master_uri", uris["master"])
+	if err := d.Set("connection_uris", uris); err != nil {
+		return diag.FromErr(err)
+	}
+
+	checksum, err := stringListChecksum([]string{datastoreID, opts.user, opts.database, opts.format})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(checksum)
+
+	return nil
+}
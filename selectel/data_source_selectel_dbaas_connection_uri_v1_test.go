@@ -0,0 +1,55 @@
+package selectel
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/selectel/go-selvpcclient/v2/selvpcclient/resell/v2/projects"
+)
+
+func TestAccDBaaSDataSourceConnectionURIV1Basic(t *testing.T) {
+	var project projects.Project
+
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	datastoreName := acctest.RandomWithPrefix("tf-acc-ds")
+	userName := RandomWithPrefix("tf_acc_user")
+	userPassword := acctest.RandomWithPrefix("tf-acc-pass")
+	databaseName := RandomWithPrefix("tf_acc_db")
+	nodeCount := 1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckVPCV2ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBaaSDataSourceConnectionURIV1Basic(projectName, datastoreName, userName, userPassword, databaseName, nodeCount),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCV2ProjectExists("selectel_vpc_project_v2.project_tf_acc_test_1", &project),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_connection_uri_v1.connection_uri_tf_acc_test_1", "engine", "postgresql"),
+					resource.TestMatchResourceAttr("data.selectel_dbaas_connection_uri_v1.connection_uri_tf_acc_test_1", "master_uri",
+						regexp.MustCompile(fmt.Sprintf("^jdbc:postgresql://.+/%s\\?.*sslmode=verify-full", databaseName))),
+				),
+			},
+		},
+	})
+}
+
+func testAccDBaaSDataSourceConnectionURIV1Basic(projectName, datastoreName, userName, userPassword, databaseName string, nodeCount int) string {
+	return fmt.Sprintf(`
+%s
+
+data "selectel_dbaas_connection_uri_v1" "connection_uri_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  datastore_id = "${selectel_dbaas_postgresql_datastore_v1.datastore_tf_acc_test_1.id}"
+  user = "${selectel_dbaas_user_v1.user_tf_acc_test_1.name}"
+  password = "${selectel_dbaas_user_v1.user_tf_acc_test_1.password}"
+  database = "${selectel_dbaas_postgresql_database_v1.database_tf_acc_test_1.name}"
+  format = "jdbc"
+  ssl_mode = "verify-full"
+}`, testAccDBaaSPostgreSQLDatabaseV1Basic(projectName, datastoreName, userName, userPassword, databaseName, nodeCount))
+}
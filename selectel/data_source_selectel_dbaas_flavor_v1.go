@@ -2,6 +2,8 @@ package selectel
 
 import (
 	"context"
+	"errors"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,6 +15,9 @@ type flavorSearchFilter struct {
 	vcpus           int
 	ram             int
 	disk            int
+	minVcpus        int
+	minRAM          int
+	minDisk         int
 	datastoreTypeID string
 }
 
@@ -93,6 +98,18 @@ func dataSourceDBaaSFlavorV1() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 						},
+						"min_vcpus": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"min_ram": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"min_disk": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
 						"datastore_type_id": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -100,6 +117,14 @@ func dataSourceDBaaSFlavorV1() *schema.Resource {
 					},
 				},
 			},
+			"most_suitable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -128,8 +153,21 @@ func dataSourceDBaaSFlavorV1Read(ctx context.Context, d *schema.ResourceData, me
 	flavors = filterFlavorByVcpus(flavors, filter.vcpus)
 	flavors = filterFlavorByRAM(flavors, filter.ram)
 	flavors = filterFlavorByDisk(flavors, filter.disk)
+	flavors = filterFlavorByMinVcpus(flavors, filter.minVcpus)
+	flavors = filterFlavorByMinRAM(flavors, filter.minRAM)
+	flavors = filterFlavorByMinDisk(flavors, filter.minDisk)
 	flavors = filterFlavorByDatastoreTypeID(flavors, filter.datastoreTypeID)
 
+	flavorID := ""
+	if d.Get("most_suitable").(bool) {
+		if len(flavors) == 0 {
+			return diag.FromErr(errors.New("no flavors found matching the filter"))
+		}
+		flavors = []dbaas.FlavorResponse{mostSuitableFlavor(flavors)}
+		flavorID = flavors[0].ID
+	}
+	d.Set("flavor_id", flavorID)
+
 	flavorsFlatten := flattenDBaaSFlavors(flavors)
 	if err := d.Set("flavors", flavorsFlatten); err != nil {
 		return diag.FromErr(err)
@@ -166,6 +204,21 @@ func expandFlavorSearchFilter(filterSet *schema.Set) (flavorSearchFilter, error)
 		filter.disk = disk.(int)
 	}
 
+	minVcpus, ok := resourceFilterMap["min_vcpus"]
+	if ok {
+		filter.minVcpus = minVcpus.(int)
+	}
+
+	minRAM, ok := resourceFilterMap["min_ram"]
+	if ok {
+		filter.minRAM = minRAM.(int)
+	}
+
+	minDisk, ok := resourceFilterMap["min_disk"]
+	if ok {
+		filter.minDisk = minDisk.(int)
+	}
+
 	datastoreTypeID, ok := resourceFilterMap["datastore_type_id"]
 	if ok {
 		filter.datastoreTypeID = datastoreTypeID.(string)
@@ -219,6 +272,68 @@ func filterFlavorByDisk(flavors []dbaas.FlavorResponse, disk int) []dbaas.Flavor
 	return filteredFlavors
 }
 
+func filterFlavorByMinVcpus(flavors []dbaas.FlavorResponse, minVcpus int) []dbaas.FlavorResponse {
+	if minVcpus == 0 {
+		return flavors
+	}
+
+	var filteredFlavors []dbaas.FlavorResponse
+	for _, f := range flavors {
+		if f.Vcpus >= minVcpus {
+			filteredFlavors = append(filteredFlavors, f)
+		}
+	}
+
+	return filteredFlavors
+}
+
+func filterFlavorByMinRAM(flavors []dbaas.FlavorResponse, minRAM int) []dbaas.FlavorResponse {
+	if minRAM == 0 {
+		return flavors
+	}
+
+	var filteredFlavors []dbaas.FlavorResponse
+	for _, f := range flavors {
+		if f.RAM >= minRAM {
+			filteredFlavors = append(filteredFlavors, f)
+		}
+	}
+
+	return filteredFlavors
+}
+
+func filterFlavorByMinDisk(flavors []dbaas.FlavorResponse, minDisk int) []dbaas.FlavorResponse {
+	if minDisk == 0 {
+		return flavors
+	}
+
+	var filteredFlavors []dbaas.FlavorResponse
+	for _, f := range flavors {
+		if f.Disk >= minDisk {
+			filteredFlavors = append(filteredFlavors, f)
+		}
+	}
+
+	return filteredFlavors
+}
+
+// mostSuitableFlavor returns the smallest flavor ordered by vCPUs, RAM and disk.
+func mostSuitableFlavor(flavors []dbaas.FlavorResponse) dbaas.FlavorResponse {
+	sortedFlavors := make([]dbaas.FlavorResponse, len(flavors))
+	copy(sortedFlavors, flavors)
+	sort.SliceStable(sortedFlavors, func(i, j int) bool {
+		if sortedFlavors[i].Vcpus != sortedFlavors[j].Vcpus {
+			return sortedFlavors[i].Vcpus < sortedFlavors[j].Vcpus
+		}
+		if sortedFlavors[i].RAM != sortedFlavors[j].RAM {
+			return sortedFlavors[i].RAM < sortedFlavors[j].RAM
+		}
+		return sortedFlavors[i].Disk < sortedFlavors[j].Disk
+	})
+
+	return sortedFlavors[0]
+}
+
 func filterFlavorByDatastoreTypeID(flavors []dbaas.FlavorResponse, datastoreTypeID string) []dbaas.FlavorResponse {
 	if datastoreTypeID == "" {
 		return flavors
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/selectel/dbaas-go"
 	"github.com/selectel/go-selvpcclient/v2/selvpcclient/resell/v2/projects"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDBaaSFlavorsV1Basic(t *testing.T) {
@@ -114,3 +115,20 @@ data "selectel_dbaas_flavor_v1" "flavor_tf_acc_test_1" {
 }
 `, projectName)
 }
+
+func TestMostSuitableFlavor(t *testing.T) {
+	flavors := []dbaas.FlavorResponse{
+		{ID: "4-16-64", Vcpus: 4, RAM: 16384, Disk: 64},
+		{ID: "8-32-64", Vcpus: 8, RAM: 32768, Disk: 64},
+		{ID: "4-16-32", Vcpus: 4, RAM: 16384, Disk: 32},
+		{ID: "2-8-32", Vcpus: 2, RAM: 8192, Disk: 32},
+		{ID: "4-32-32", Vcpus: 4, RAM: 32768, Disk: 32},
+	}
+
+	filtered := filterFlavorByMinVcpus(flavors, 4)
+	filtered = filterFlavorByMinRAM(filtered, 16384)
+	filtered = filterFlavorByMinDisk(filtered, 0)
+
+	assert.Len(t, filtered, 4)
+	assert.Equal(t, "4-16-32", mostSuitableFlavor(filtered).ID)
+}
@@ -0,0 +1,187 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/selectel/domains-go/pkg/v1/record"
+)
+
+type recordSearchFilter struct {
+	name         string
+	recordType   string
+	contentRegex *regexp.Regexp
+}
+
+func dataSourceDomainsRecordsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsRecordsV1Read,
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								TypeRecordA,
+								TypeRecordAAAA,
+								TypeRecordTXT,
+								TypeRecordCNAME,
+								TypeRecordNS,
+								TypeRecordSOA,
+								TypeRecordMX,
+								TypeRecordSRV,
+								TypeRecordCAA,
+								TypeRecordSSHFP,
+								TypeRecordALIAS,
+							}, false),
+						},
+						"content_regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+					},
+				},
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     domainsV1RecordSchema(),
+			},
+		},
+	}
+}
+
+func dataSourceDomainsRecordsV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client := config.domainsV1Client()
+	domainID := d.Get("domain_id").(int)
+
+	log.Print(msgGet(objectRecord, fmt.Sprintf("domain %d", domainID)))
+
+	records, _, err := record.ListByDomainID(ctx, client, domainID)
+	if err != nil {
+		return diag.FromErr(errGettingObjects(objectRecord, err))
+	}
+
+	filter, err := expandRecordSearchFilter(d.Get("filter").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	records = filterRecordsByName(records, filter.name)
+	records = filterRecordsByType(records, filter.recordType)
+	records = filterRecordsByContentRegex(records, filter.contentRegex)
+
+	recordIDs := []string{strconv.Itoa(domainID)}
+	for _, r := range records {
+		recordIDs = append(recordIDs, strconv.Itoa(r.ID))
+	}
+
+	if err := d.Set("records", flattenDomainsV1Records(records)); err != nil {
+		return diag.FromErr(err)
+	}
+	checksum, err := stringListChecksum(recordIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(checksum)
+
+	return nil
+}
+
+func expandRecordSearchFilter(filterSet *schema.Set) (recordSearchFilter, error) {
+	filter := recordSearchFilter{}
+	if filterSet.Len() == 0 {
+		return filter, nil
+	}
+
+	resourceFilterMap := filterSet.List()[0].(map[string]interface{})
+
+	name, ok := resourceFilterMap["name"]
+	if ok {
+		filter.name = name.(string)
+	}
+
+	recordType, ok := resourceFilterMap["type"]
+	if ok {
+		filter.recordType = recordType.(string)
+	}
+
+	contentRegex, ok := resourceFilterMap["content_regex"]
+	if ok && contentRegex.(string) != "" {
+		re, err := regexp.Compile(contentRegex.(string))
+		if err != nil {
+			return filter, err
+		}
+		filter.contentRegex = re
+	}
+
+	return filter, nil
+}
+
+func filterRecordsByName(records []*record.View, name string) []*record.View {
+	if name == "" {
+		return records
+	}
+
+	var filteredRecords []*record.View
+	for _, r := range records {
+		if domainsV1RecordNamesEqual(r.Name, name) {
+			filteredRecords = append(filteredRecords, r)
+		}
+	}
+
+	return filteredRecords
+}
+
+func filterRecordsByType(records []*record.View, recordType string) []*record.View {
+	if recordType == "" {
+		return records
+	}
+
+	var filteredRecords []*record.View
+	for _, r := range records {
+		if strings.EqualFold(string(r.Type), recordType) {
+			filteredRecords = append(filteredRecords, r)
+		}
+	}
+
+	return filteredRecords
+}
+
+// filterRecordsByContentRegex matches the regex against the content of the record
+// or against its data in the zone file format for records without content, e.g. SRV.
+func filterRecordsByContentRegex(records []*record.View, contentRegex *regexp.Regexp) []*record.View {
+	if contentRegex == nil {
+		return records
+	}
+
+	var filteredRecords []*record.View
+	for _, r := range records {
+		if contentRegex.MatchString(r.Content) || contentRegex.MatchString(domainsV1RecordRData(r)) {
+			filteredRecords = append(filteredRecords, r)
+		}
+	}
+
+	return filteredRecords
+}
@@ -0,0 +1,80 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/selectel/domains-go/pkg/v1/domain"
+)
+
+func TestAccDomainsRecordsV1DataSourceBasic(t *testing.T) {
+	var testDomain domain.View
+	testDomainName := fmt.Sprintf("%s.xyz", acctest.RandomWithPrefix("tf-acc"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV1DomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsRecordsV1DataSourceBasic(testDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainsDomainV1Exists("selectel_domains_domain_v1.domain_tf_acc_test_1", &testDomain),
+					resource.TestCheckResourceAttr("data.selectel_domains_records_v1.records_tf_acc_test_1", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.selectel_domains_records_v1.records_tf_acc_test_1", "records.0.name", testDomainName),
+					resource.TestCheckResourceAttr("data.selectel_domains_records_v1.records_tf_acc_test_1", "records.0.type", "TXT"),
+					resource.TestCheckResourceAttr("data.selectel_domains_records_v1.records_tf_acc_test_1", "records.0.content", "site-verification=abc123"),
+					resource.TestCheckResourceAttr("data.selectel_domains_records_v1.mx_tf_acc_test_1", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.selectel_domains_records_v1.mx_tf_acc_test_1", "records.0.priority", "10"),
+					resource.TestCheckResourceAttr("data.selectel_domains_records_v1.mx_tf_acc_test_1", "records.0.content", "mail.example.org"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsRecordsV1DataSourceBasic(domainName string) string {
+	return fmt.Sprintf(`
+resource "selectel_domains_domain_v1" "domain_tf_acc_test_1" {
+  name = "%[1]s"
+}
+
+resource "selectel_domains_rrset_v1" "txt_tf_acc_test_1" {
+  domain_id = selectel_domains_domain_v1.domain_tf_acc_test_1.id
+  name = "%[1]s"
+  type = "TXT"
+  ttl = 60
+  records = ["v=spf1 ~all", "site-verification=abc123"]
+}
+
+resource "selectel_domains_rrset_v1" "mx_tf_acc_test_1" {
+  domain_id = selectel_domains_domain_v1.domain_tf_acc_test_1.id
+  name = "%[1]s"
+  type = "MX"
+  ttl = 60
+  records = ["10 mail.example.org"]
+}
+
+data "selectel_domains_records_v1" "records_tf_acc_test_1" {
+  domain_id = selectel_domains_domain_v1.domain_tf_acc_test_1.id
+  filter {
+    name = "%[1]s"
+    type = "TXT"
+    content_regex = "^site-verification="
+  }
+
+  depends_on = [selectel_domains_rrset_v1.txt_tf_acc_test_1]
+}
+
+data "selectel_domains_records_v1" "mx_tf_acc_test_1" {
+  domain_id = selectel_domains_domain_v1.domain_tf_acc_test_1.id
+  filter {
+    type = "MX"
+  }
+
+  depends_on = [selectel_domains_rrset_v1.mx_tf_acc_test_1]
+}
+`, domainName)
+}
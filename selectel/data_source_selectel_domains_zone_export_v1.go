@@ -0,0 +1,80 @@
+package selectel
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/domains-go/pkg/v1/domain"
+	"github.com/selectel/domains-go/pkg/v1/record"
+)
+
+func dataSourceDomainsZoneExportV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsZoneExportV1Read,
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"domain_id", "domain_name"},
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"domain_id", "domain_name"},
+			},
+			"zone_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     domainsV1RecordSchema(),
+			},
+		},
+	}
+}
+
+func dataSourceDomainsZoneExportV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client := config.domainsV1Client()
+
+	var (
+		domainObj *domain.View
+		err       error
+	)
+	if domainName, ok := d.GetOk("domain_name"); ok {
+		log.Print(msgGet(objectDomain, domainName.(string)))
+		domainObj, _, err = domain.GetByName(ctx, client, domainName.(string))
+		if err != nil {
+			return diag.FromErr(errGettingObject(objectDomain, domainName.(string), err))
+		}
+	} else {
+		domainID := d.Get("domain_id").(int)
+		log.Print(msgGet(objectDomain, strconv.Itoa(domainID)))
+		domainObj, _, err = domain.GetByID(ctx, client, domainID)
+		if err != nil {
+			return diag.FromErr(errGettingObject(objectDomain, strconv.Itoa(domainID), err))
+		}
+	}
+
+	records, _, err := record.ListByDomainID(ctx, client, domainObj.ID)
+	if err != nil {
+		return diag.FromErr(errGettingObjects(objectRecord, err))
+	}
+
+	d.SetId(strconv.Itoa(domainObj.ID))
+	d.Set("domain_id", domainObj.ID)
+	d.Set("domain_name", domainObj.Name)
+	d.Set("zone_file", domainsV1RenderZoneFile(domainObj.Name, records))
+	if err := d.Set("records", flattenDomainsV1Records(domainsV1SortZoneRecords(domainObj.Name, records))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
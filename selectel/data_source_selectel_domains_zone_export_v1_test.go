@@ -0,0 +1,52 @@
+package selectel
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/selectel/domains-go/pkg/v1/domain"
+)
+
+func TestAccDomainsZoneExportV1DataSourceBasic(t *testing.T) {
+	var testDomain domain.View
+	testDomainName := fmt.Sprintf("%s.xyz", acctest.RandomWithPrefix("tf-acc"))
+	testRecordName := fmt.Sprintf("a.%s", testDomainName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV1DomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsZoneExportV1DataSourceBasic(testDomainName, testRecordName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainsDomainV1Exists("selectel_domains_domain_v1.domain_tf_acc_test_1", &testDomain),
+					resource.TestCheckResourceAttr("data.selectel_domains_zone_export_v1.zone_tf_acc_test_1", "domain_name", testDomainName),
+					resource.TestMatchResourceAttr("data.selectel_domains_zone_export_v1.zone_tf_acc_test_1", "zone_file",
+						regexp.MustCompile(fmt.Sprintf(`(?m)^%s\.\t60\tIN\tA\t127\.0\.0\.1$`, regexp.QuoteMeta(testRecordName)))),
+					resource.TestCheckTypeSetElemNestedAttrs("data.selectel_domains_zone_export_v1.zone_tf_acc_test_1", "records.*", map[string]string{
+						"name":    testRecordName,
+						"type":    "A",
+						"content": "127.0.0.1",
+						"rdata":   "127.0.0.1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsZoneExportV1DataSourceBasic(domainName, recordName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "selectel_domains_zone_export_v1" "zone_tf_acc_test_1" {
+  domain_id = "${selectel_domains_domain_v1.domain_tf_acc_test_1.id}"
+
+  depends_on = [selectel_domains_record_v1.record_a_tf_acc_test_1]
+}
+`, testAccDomainsRecordV1BasicSingle(domainName, recordName))
+}
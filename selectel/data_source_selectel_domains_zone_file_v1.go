@@ -0,0 +1,177 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDomainsZoneFileV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsZoneFileV1Read,
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(60, 604800),
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rdata": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"rrsets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"skipped_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainsZoneFileV1Read(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	content := d.Get("content").(string)
+	origin := d.Get("origin").(string)
+
+	zone, err := domainsV1ParseZoneFile(content, origin, d.Get("default_ttl").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	records, err := flattenDomainsV1ZoneRecords(zone.records)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("records", records); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rrsets", flattenDomainsV1ZoneRRSets(zone.records)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("skipped_records", zone.skipped); err != nil {
+		return diag.FromErr(err)
+	}
+
+	checksum, err := stringListChecksum([]string{origin, content})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(checksum)
+
+	return nil
+}
+
+// flattenDomainsV1ZoneRecords flattens zone file records. IDs are based on the name,
+// the type and the data of the record, so they don't change when records are reordered.
+func flattenDomainsV1ZoneRecords(records []domainsV1ZoneRecord) ([]interface{}, error) {
+	recordsList := make([]interface{}, len(records))
+	for i, r := range records {
+		id, err := stringChecksum(fmt.Sprintf("%s/%s/%s", strings.ToLower(r.name), r.recordType, r.rdata))
+		if err != nil {
+			return nil, err
+		}
+		recordsList[i] = map[string]interface{}{
+			"id":    id,
+			"name":  r.name,
+			"type":  r.recordType,
+			"ttl":   r.ttl,
+			"rdata": r.rdata,
+		}
+	}
+
+	return recordsList, nil
+}
+
+// flattenDomainsV1ZoneRRSets groups zone file records by the name and the type
+// in the order of their first appearance. The TTL of the first record is used.
+func flattenDomainsV1ZoneRRSets(records []domainsV1ZoneRecord) []interface{} {
+	var rrsetsList []interface{}
+	rrsets := make(map[string]map[string]interface{})
+	for _, r := range records {
+		id := fmt.Sprintf("%s/%s", strings.ToLower(r.name), r.recordType)
+		rrset, ok := rrsets[id]
+		if !ok {
+			rrset = map[string]interface{}{
+				"id":      id,
+				"name":    r.name,
+				"type":    r.recordType,
+				"ttl":     r.ttl,
+				"records": []string{},
+			}
+			rrsets[id] = rrset
+			rrsetsList = append(rrsetsList, rrset)
+		}
+		rrset["records"] = append(rrset["records"].([]string), r.rdata)
+	}
+
+	return rrsetsList
+}
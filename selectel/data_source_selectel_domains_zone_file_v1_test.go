@@ -0,0 +1,41 @@
+package selectel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainsZoneFileV1DataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsZoneFileV1DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.selectel_domains_zone_file_v1.zone_tf_acc_test_1", "records.#", "3"),
+					resource.TestCheckResourceAttr("data.selectel_domains_zone_file_v1.zone_tf_acc_test_1", "records.0.name", "www.example.org"),
+					resource.TestCheckResourceAttr("data.selectel_domains_zone_file_v1.zone_tf_acc_test_1", "records.0.ttl", "300"),
+					resource.TestCheckResourceAttr("data.selectel_domains_zone_file_v1.zone_tf_acc_test_1", "rrsets.#", "2"),
+					resource.TestCheckResourceAttr("data.selectel_domains_zone_file_v1.zone_tf_acc_test_1", "rrsets.0.id", "www.example.org/A"),
+					resource.TestCheckResourceAttr("data.selectel_domains_zone_file_v1.zone_tf_acc_test_1", "rrsets.0.records.#", "2"),
+					resource.TestCheckResourceAttr("data.selectel_domains_zone_file_v1.zone_tf_acc_test_1", "rrsets.1.records.0", "10 mail.example.org"),
+					resource.TestCheckResourceAttr("data.selectel_domains_zone_file_v1.zone_tf_acc_test_1", "skipped_records.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDomainsZoneFileV1DataSourceBasic = `
+data "selectel_domains_zone_file_v1" "zone_tf_acc_test_1" {
+  origin  = "example.org"
+  content = <<EOT
+@   IN SOA ns1.selectel.org. support.selectel.ru. 1 10800 3600 604800 60
+www 300 IN A 127.0.0.1
+www 300 IN A 127.0.0.2
+@   IN MX 10 mail
+EOT
+}
+`
@@ -3,6 +3,7 @@ package selectel
 import (
 	"context"
 	"crypto/md5"
+	crand "crypto/rand"
 	"errors"
 	"fmt"
 	"log"
@@ -30,6 +31,13 @@ const (
 	uz1DBaaSV1Endpoint = "https://uz-1.dbaas.selcloud.ru/v1"
 )
 
+const dbaasLatestEngineVersion = "latest"
+
+const (
+	dbaasGeneratedPasswordLength = 32
+	dbaasGeneratedPasswordChars  = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
 func getDBaaSV1Endpoint(region string) (endpoint string) {
 	switch region {
 	case ru1Region:
@@ -52,23 +60,33 @@ func getDBaaSV1Endpoint(region string) (endpoint string) {
 }
 
 func getDBaaSClient(ctx context.Context, d *schema.ResourceData, meta interface{}) (*dbaas.API, diag.Diagnostics) {
+	projectID := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+	client, err := newDBaaSClient(ctx, meta, projectID, region)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return client, nil
+}
+
+func newDBaaSClient(ctx context.Context, meta interface{}, projectID, region string) (*dbaas.API, error) {
 	config := meta.(*Config)
 	resellV2Client := config.resellV2Client()
 	tokenOpts := tokens.TokenOpts{
-		ProjectID: d.Get("project_id").(string),
+		ProjectID: projectID,
 	}
 
 	log.Print(msgCreate(objectToken, tokenOpts))
 	token, _, err := tokens.Create(ctx, resellV2Client, tokenOpts)
 	if err != nil {
-		return nil, diag.FromErr((errCreatingObject(objectToken, err)))
+		return nil, errCreatingObject(objectToken, err)
 	}
 
-	region := d.Get("region").(string)
 	endpoint := getDBaaSV1Endpoint(region)
 	client, err := dbaas.NewDBAASClient(token.ID, endpoint)
 	if err != nil {
-		return nil, diag.FromErr(err)
+		return nil, err
 	}
 
 	return client, nil
@@ -352,13 +370,18 @@ func updateDatastoreConfig(ctx context.Context, d *schema.ResourceData, client *
 		return err
 	}
 	config := d.Get("config").(map[string]interface{})
-
-	for param := range datastore.Config {
+	for param := range datastoreConfigResetParams(d, datastore.Config) {
 		if _, ok := config[param]; !ok {
 			config[param] = nil
 		}
 	}
 
+	typeID := d.Get("type_id").(string)
+	config, err = convertDatastoreConfigByType(ctx, client, typeID, config)
+	if err != nil {
+		return errUpdatingObject(objectDatastore, d.Id(), err)
+	}
+
 	configOpts.Config = config
 
 	log.Print(msgUpdate(objectDatastore, d.Id(), configOpts))
@@ -377,6 +400,377 @@ func updateDatastoreConfig(ctx context.Context, d *schema.ResourceData, client *
 	return nil
 }
 
+// datastoreConfigResetParams returns parameters that are reset to their defaults
+// when they are absent in the config. In the managed keys only mode only parameters
+// that were previously set by Terraform are reset.
+func datastoreConfigResetParams(d *schema.ResourceData, datastoreConfig map[string]interface{}) map[string]interface{} {
+	if !datastoreConfigManagedKeysOnly(d) {
+		return datastoreConfig
+	}
+
+	// The state holds every parameter of the datastore until the mode is turned on,
+	// so none of them were set by Terraform.
+	if d.HasChange("config_managed_keys_only") {
+		return nil
+	}
+	oldConfig, _ := d.GetChange("config")
+
+	return oldConfig.(map[string]interface{})
+}
+
+// dbaasDatastoreV1ConfigManagedKeysCustomizeDiff leaves only configured parameters
+// in the planned config in the managed keys only mode. Otherwise the config keeps
+// every parameter of the datastore from the state when it's omitted or when
+// the mode is turned on.
+func dbaasDatastoreV1ConfigManagedKeysCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	managedKeysOnly, ok := d.Get("config_managed_keys_only").(bool)
+	if !ok || !managedKeysOnly || !d.NewValueKnown("config") {
+		return nil
+	}
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	configuredConfig := rawConfig.GetAttr("config")
+	if !configuredConfig.IsKnown() {
+		return nil
+	}
+
+	plannedConfig := d.Get("config").(map[string]interface{})
+	managedConfig := make(map[string]interface{}, len(plannedConfig))
+	if !configuredConfig.IsNull() {
+		for param := range configuredConfig.AsValueMap() {
+			if value, ok := plannedConfig[param]; ok {
+				managedConfig[param] = value
+			}
+		}
+	}
+	if len(managedConfig) == len(plannedConfig) {
+		return nil
+	}
+
+	return d.SetNew("config", managedConfig)
+}
+
+// dbaasDatastoreV1ConfigCustomizeDiff validates changed config parameters. The datastore
+// type is resolved by the engine and the engine_version when type_id isn't known yet.
+func dbaasDatastoreV1ConfigCustomizeDiff(engine string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.HasChange("config") {
+			return nil
+		}
+		// Configuration parameters catalog can't be loaded until the project, the region
+		// and the datastore type are known, so the validation is left to the API then.
+		for _, key := range []string{"project_id", "region", "config"} {
+			if !d.NewValueKnown(key) {
+				return nil
+			}
+		}
+		var typeID, version string
+		if d.NewValueKnown("type_id") {
+			typeID = d.Get("type_id").(string)
+		}
+		if typeID == "" && d.NewValueKnown("engine_version") {
+			version = d.Get("engine_version").(string)
+		}
+		if typeID == "" && version == "" {
+			return nil
+		}
+
+		oldConfigRaw, newConfigRaw := d.GetChange("config")
+		oldConfig := oldConfigRaw.(map[string]interface{})
+		changedConfig := make(map[string]string)
+		for param, value := range newConfigRaw.(map[string]interface{}) {
+			if oldValue, ok := oldConfig[param]; ok && datastoreConfigValuesEqual(oldValue.(string), value.(string)) {
+				continue
+			}
+			changedConfig[param] = value.(string)
+		}
+		if len(changedConfig) == 0 {
+			return nil
+		}
+
+		projectID := d.Get("project_id").(string)
+		region := d.Get("region").(string)
+		dbaasClient, err := newDBaaSClient(ctx, meta, projectID, region)
+		if err != nil {
+			return err
+		}
+		if typeID == "" {
+			typeID, err = resolveDatastoreTypeID(ctx, dbaasClient, engine, version)
+			if err != nil {
+				return err
+			}
+		}
+
+		configurationParameters, err := getDatastoreTypeConfigurationParameters(ctx, dbaasClient, typeID)
+		if err != nil {
+			return err
+		}
+		if len(configurationParameters) == 0 {
+			log.Printf("[DEBUG] no configuration parameters found for the datastore type %s, skipping config validation", typeID)
+			return nil
+		}
+
+		return validateDatastoreConfig(typeID, changedConfig, configurationParameters)
+	}
+}
+
+func getDatastoreTypeConfigurationParameters(ctx context.Context, client *dbaas.API, typeID string) ([]dbaas.ConfigurationParameter, error) {
+	configurationParameters, err := client.ConfigurationParameters(ctx)
+	if err != nil {
+		return nil, errGettingObjects(objectConfigurationParameters, err)
+	}
+
+	return filterConfigurationParametersByDatastoreTypeID(configurationParameters, typeID), nil
+}
+
+func validateDatastoreConfig(typeID string, config map[string]string, configurationParameters []dbaas.ConfigurationParameter) error {
+	paramsByName := make(map[string]dbaas.ConfigurationParameter, len(configurationParameters))
+	for _, param := range configurationParameters {
+		paramsByName[param.Name] = param
+	}
+
+	names := make([]string, 0, len(config))
+	for name := range config {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		param, ok := paramsByName[name]
+		if !ok {
+			errs = append(errs, errUnknownDatastoreConfigParameter(name, typeID))
+			continue
+		}
+		if err := validateDatastoreConfigParameter(param, config[name]); err != nil {
+			errs = append(errs, errInvalidDatastoreConfigParameter(name, err))
+			continue
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// restartRequiredDatastoreConfigParams returns sorted names of the changed or removed
+// configuration parameters that require the datastore restart.
+func restartRequiredDatastoreConfigParams(oldConfig, newConfig map[string]interface{}, configurationParameters []dbaas.ConfigurationParameter) []string {
+	var names []string
+	for _, param := range configurationParameters {
+		if !param.IsRestartRequired {
+			continue
+		}
+		oldValue, oldOk := oldConfig[param.Name]
+		newValue, newOk := newConfig[param.Name]
+		if oldOk == newOk && (!oldOk || datastoreConfigValuesEqual(oldValue.(string), newValue.(string))) {
+			continue
+		}
+		names = append(names, param.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// dbaasDatastoreV1ConfigRestartWarning returns a warning if the config change
+// contains parameters that require the datastore restart.
+func dbaasDatastoreV1ConfigRestartWarning(ctx context.Context, d *schema.ResourceData, client *dbaas.API) diag.Diagnostics {
+	configurationParameters, err := getDatastoreTypeConfigurationParameters(ctx, client, d.Get("type_id").(string))
+	if err != nil {
+		log.Printf("[DEBUG] can't check if the datastore %s config change requires a restart: %s", d.Id(), err)
+		return nil
+	}
+
+	oldConfig, newConfig := d.GetChange("config")
+	names := restartRequiredDatastoreConfigParams(
+		oldConfig.(map[string]interface{}), newConfig.(map[string]interface{}), configurationParameters)
+	if len(names) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Datastore restart required",
+		Detail: fmt.Sprintf("Configuration parameters %s of the datastore %s take effect only after the datastore restart.",
+			strings.Join(names, ", "), d.Id()),
+	}}
+}
+
+func validateDatastoreConfigParameter(param dbaas.ConfigurationParameter, value string) error {
+	if !param.IsChangeable {
+		return errors.New("parameter can't be changed")
+	}
+
+	var numericValue float64
+	var isNumeric bool
+	switch param.Type {
+	case "int":
+		intValue, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("expected an integer, got '%s'", value)
+		}
+		numericValue, isNumeric = float64(intValue), true
+	case "float":
+		floatValue, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("expected a float, got '%s'", value)
+		}
+		numericValue, isNumeric = floatValue, true
+	case "bool", "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("expected a boolean, got '%s'", value)
+		}
+	}
+
+	if isNumeric {
+		if min, ok := configurationParameterBound(param.Min); ok && numericValue < min {
+			return fmt.Errorf("%s is less than the minimum value %s", value, convertFieldToStringByType(param.Min))
+		}
+		if max, ok := configurationParameterBound(param.Max); ok && numericValue > max {
+			return fmt.Errorf("%s is greater than the maximum value %s", value, convertFieldToStringByType(param.Max))
+		}
+	}
+
+	if len(param.Choices) > 0 {
+		choices := make([]string, len(param.Choices))
+		for i, choice := range param.Choices {
+			choices[i] = convertFieldToStringByType(choice)
+			if choices[i] == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("'%s' is not one of [%s]", value, strings.Join(choices, ", "))
+	}
+
+	return nil
+}
+
+func configurationParameterBound(bound interface{}) (float64, bool) {
+	switch boundValue := bound.(type) {
+	case int:
+		return float64(boundValue), true
+	case float64:
+		return boundValue, true
+	case string:
+		value, err := strconv.ParseFloat(boundValue, 64)
+		if err != nil {
+			return 0, false
+		}
+		return value, true
+	default:
+		return 0, false
+	}
+}
+
+func datastoreConfigManagedKeysOnly(d *schema.ResourceData) bool {
+	managedKeysOnly, ok := d.GetOk("config_managed_keys_only")
+
+	return ok && managedKeysOnly.(bool)
+}
+
+// flattenDatastoreConfig converts datastore config values to strings. In the managed
+// keys only mode parameters that aren't set by Terraform are skipped.
+func flattenDatastoreConfig(d *schema.ResourceData, datastoreConfig map[string]interface{}) map[string]string {
+	managedKeysOnly := datastoreConfigManagedKeysOnly(d)
+	managedConfig := d.Get("config").(map[string]interface{})
+
+	configMap := make(map[string]string)
+	for key, value := range datastoreConfig {
+		if _, ok := managedConfig[key]; managedKeysOnly && !ok {
+			continue
+		}
+		configMap[key] = convertFieldToStringByType(value)
+	}
+
+	return configMap
+}
+
+// convertDatastoreConfigByType converts config values to the types of the
+// configuration parameters of the datastore type. Values of unknown parameters
+// and values that can't be converted are sent as is.
+func convertDatastoreConfigByType(ctx context.Context, client *dbaas.API, typeID string, config map[string]interface{}) (map[string]interface{}, error) {
+	if len(config) == 0 {
+		return config, nil
+	}
+
+	configurationParameters, err := getDatastoreTypeConfigurationParameters(ctx, client, typeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertDatastoreConfigValuesByType(config, configurationParameters), nil
+}
+
+func convertDatastoreConfigValuesByType(config map[string]interface{}, configurationParameters []dbaas.ConfigurationParameter) map[string]interface{} {
+	paramTypes := make(map[string]string, len(configurationParameters))
+	for _, param := range configurationParameters {
+		paramTypes[param.Name] = param.Type
+	}
+
+	convertedConfig := make(map[string]interface{}, len(config))
+	for param, value := range config {
+		stringValue, ok := value.(string)
+		if !ok {
+			convertedConfig[param] = value
+			continue
+		}
+		convertedConfig[param] = convertDatastoreConfigValueByType(paramTypes[param], stringValue)
+	}
+
+	return convertedConfig
+}
+
+func convertDatastoreConfigValueByType(paramType, value string) interface{} {
+	switch paramType {
+	case "int":
+		if intValue, err := strconv.Atoi(value); err == nil {
+			return intValue
+		}
+	case "float":
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	case "bool", "boolean":
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+
+	return value
+}
+
+// datastoreConfigValuesEqual reports whether two config values represent the same
+// number or the same boolean, e.g. "0.5" and "0.50" or "true" and "1".
+func datastoreConfigValuesEqual(old, new string) bool {
+	if old == new {
+		return true
+	}
+
+	oldFloat, oldErr := strconv.ParseFloat(old, 64)
+	newFloat, newErr := strconv.ParseFloat(new, 64)
+	if oldErr == nil && newErr == nil {
+		return oldFloat == newFloat
+	}
+
+	oldBool, oldErr := strconv.ParseBool(old)
+	newBool, newErr := strconv.ParseBool(new)
+	if oldErr == nil && newErr == nil {
+		return oldBool == newBool
+	}
+
+	return false
+}
+
+func dbaasDatastoreV1ConfigDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	// Skip the number of elements in the map.
+	if strings.HasSuffix(k, ".%") {
+		return false
+	}
+
+	return datastoreConfigValuesEqual(old, new)
+}
+
 func resizeDatastore(ctx context.Context, d *schema.ResourceData, client *dbaas.API) error {
 	var resizeOpts dbaas.DatastoreResizeOpts
 	nodeCount := d.Get("node_count").(int)
@@ -420,6 +814,34 @@ func resizeDatastore(ctx context.Context, d *schema.ResourceData, client *dbaas.
 	return nil
 }
 
+// resizeDatastoreByFlavorID resizes datastores that are created only with the flavor_id,
+// e.g. Redis and Kafka datastores.
+func resizeDatastoreByFlavorID(ctx context.Context, d *schema.ResourceData, client *dbaas.API) error {
+	var resizeOpts dbaas.DatastoreResizeOpts
+	nodeCount := d.Get("node_count").(int)
+	resizeOpts.NodeCount = nodeCount
+
+	flavorID := d.Get("flavor_id")
+
+	resizeOpts.Flavor = nil
+	resizeOpts.FlavorID = flavorID.(string)
+
+	log.Print(msgUpdate(objectDatastore, d.Id(), resizeOpts))
+	_, err := client.ResizeDatastore(ctx, d.Id(), resizeOpts)
+	if err != nil {
+		return errUpdatingObject(objectDatastore, d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] waiting for datastore %s to become 'ACTIVE'", d.Id())
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = waitForDBaaSDatastoreV1ActiveState(ctx, client, d.Id(), timeout)
+	if err != nil {
+		return errUpdatingObject(objectDatastore, d.Id(), err)
+	}
+
+	return nil
+}
+
 func validateDatastoreType(ctx context.Context, expectedDatastoreTypeEngine string, typeID string, client *dbaas.API) diag.Diagnostics {
 	datastoreType, err := client.DatastoreType(ctx, typeID)
 	if err != nil {
@@ -432,6 +854,105 @@ func validateDatastoreType(ctx context.Context, expectedDatastoreTypeEngine stri
 	return nil
 }
 
+// getDatastoreTypeID returns the type_id of the datastore or resolves it
+// by the engine and the engine_version.
+func getDatastoreTypeID(ctx context.Context, d *schema.ResourceData, client *dbaas.API, engine string) (string, error) {
+	if typeID, ok := d.GetOk("type_id"); ok {
+		return typeID.(string), nil
+	}
+
+	// Either type_id or engine_version is set, that is checked by AtLeastOneOf.
+	version := d.Get("engine_version").(string)
+
+	return resolveDatastoreTypeID(ctx, client, engine, version)
+}
+
+// resolveDatastoreTypeID returns the ID of the datastore type with the engine and the version.
+func resolveDatastoreTypeID(ctx context.Context, client *dbaas.API, engine, version string) (string, error) {
+	log.Print(msgGet(objectDatastoreTypes, engine+" "+version))
+	datastoreTypes, err := client.DatastoreTypes(ctx)
+	if err != nil {
+		return "", errGettingObjects(objectDatastoreTypes, err)
+	}
+
+	datastoreType, err := findDatastoreType(datastoreTypes, engine, version)
+	if err != nil {
+		return "", err
+	}
+
+	return datastoreType.ID, nil
+}
+
+// findDatastoreType returns the datastore type with the engine and the version.
+// The latest version of the engine is returned for the "latest" version.
+func findDatastoreType(datastoreTypes []dbaas.DatastoreType, engine, version string) (dbaas.DatastoreType, error) {
+	var found *dbaas.DatastoreType
+	for i, datastoreType := range datastoreTypes {
+		if datastoreType.Engine != engine {
+			continue
+		}
+		if version != dbaasLatestEngineVersion {
+			if datastoreType.Version == version {
+				return datastoreType, nil
+			}
+			continue
+		}
+		if found == nil || compareDatastoreTypeVersions(datastoreType.Version, found.Version) > 0 {
+			found = &datastoreTypes[i]
+		}
+	}
+	if found == nil {
+		return dbaas.DatastoreType{}, fmt.Errorf("datastore type with engine %s and version %s is not found", engine, version)
+	}
+
+	return *found, nil
+}
+
+// compareDatastoreTypeVersions compares dot-separated versions numerically.
+// Non-numeric parts are compared as strings.
+func compareDatastoreTypeVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aNum, aErr := strconv.Atoi(aParts[i])
+		bNum, bErr := strconv.Atoi(bParts[i])
+		switch {
+		case aErr == nil && bErr == nil && aNum != bNum:
+			if aNum < bNum {
+				return -1
+			}
+			return 1
+		case (aErr != nil || bErr != nil) && aParts[i] != bParts[i]:
+			return strings.Compare(aParts[i], bParts[i])
+		}
+	}
+
+	switch {
+	case len(aParts) < len(bParts):
+		return -1
+	case len(aParts) > len(bParts):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func setDatastoreTypeEngine(ctx context.Context, d *schema.ResourceData, client *dbaas.API, typeID string) {
+	datastoreType, err := client.DatastoreType(ctx, typeID)
+	if err != nil {
+		log.Printf("[DEBUG] %s", errGettingObject(objectDatastoreTypes, typeID, err))
+		return
+	}
+
+	d.Set("engine", datastoreType.Engine)
+	d.Set("engine_version", datastoreType.Version)
+}
+
+func dbaasDatastoreV1EngineVersionDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	// The "latest" version is resolved only once on the datastore creation.
+	return new == dbaasLatestEngineVersion && old != ""
+}
+
 func waitForDBaaSDatabaseV1ActiveState(
 	ctx context.Context, client *dbaas.API, databaseID string, timeout time.Duration,
 ) error {
@@ -558,3 +1079,87 @@ func dbaasUserV1DeleteStateRefreshFunc(ctx context.Context, client *dbaas.API, u
 		return d, strconv.Itoa(200), err
 	}
 }
+
+// Passwords
+
+func generateDBaaSPassword() (string, error) {
+	password := make([]byte, dbaasGeneratedPasswordLength)
+	randomBytes := make([]byte, dbaasGeneratedPasswordLength)
+	charsCount := byte(len(dbaasGeneratedPasswordChars))
+	// Largest multiple of the chars count that fits into a byte, bytes above it
+	// are skipped to keep the distribution uniform.
+	maxByte := 255 - (255 % charsCount)
+
+	for i := 0; i < dbaasGeneratedPasswordLength; {
+		if _, err := crand.Read(randomBytes); err != nil {
+			return "", err
+		}
+		for _, b := range randomBytes {
+			if b >= maxByte {
+				continue
+			}
+			password[i] = dbaasGeneratedPasswordChars[b%charsCount]
+			i++
+			if i == dbaasGeneratedPasswordLength {
+				break
+			}
+		}
+	}
+
+	return string(password), nil
+}
+
+// getDBaaSPassword returns the password from the passwordKey attribute or generates
+// a new one and stores it in the generated_password attribute if generate_password is set.
+// The generated_password attribute is cleared by dbaasPasswordCustomizeDiff otherwise.
+func getDBaaSPassword(d *schema.ResourceData, passwordKey string) (string, error) {
+	if generatePassword, _ := d.Get("generate_password").(bool); !generatePassword {
+		password, _ := d.Get(passwordKey).(string)
+		return password, nil
+	}
+
+	password, err := generateDBaaSPassword()
+	if err != nil {
+		return "", err
+	}
+	d.Set("generated_password", password)
+
+	return password, nil
+}
+
+func dbaasPasswordHasChange(d *schema.ResourceData, passwordKey string) bool {
+	if generatePassword, _ := d.Get("generate_password").(bool); generatePassword {
+		return d.HasChanges("generate_password", "rotation_id")
+	}
+
+	return d.HasChange(passwordKey)
+}
+
+// dbaasPasswordCustomizeDiff checks that exactly one of the passwordKey attribute and
+// generate_password is set and plans the generated_password attribute.
+func dbaasPasswordCustomizeDiff(passwordKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		generatePassword, _ := d.Get("generate_password").(bool)
+		password, _ := d.Get(passwordKey).(string)
+		passwordSet := password != "" || !d.NewValueKnown(passwordKey)
+
+		if generatePassword && passwordSet {
+			return errDBaaSPasswordConflict(passwordKey)
+		}
+		if !generatePassword && !passwordSet {
+			return errDBaaSPasswordRequired(passwordKey)
+		}
+
+		if !generatePassword {
+			if generatedPassword, _ := d.Get("generated_password").(string); generatedPassword != "" {
+				return d.SetNew("generated_password", "")
+			}
+			return nil
+		}
+		if d.Id() == "" || d.HasChanges("generate_password", "rotation_id") {
+			return d.SetNewComputed("generated_password")
+		}
+
+		return nil
+	}
+}
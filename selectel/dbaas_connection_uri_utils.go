@@ -0,0 +1,135 @@
+package selectel
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/dbaas-go"
+)
+
+const (
+	dbaasConnectionURIFormatURI  = "uri"
+	dbaasConnectionURIFormatJDBC = "jdbc"
+)
+
+// dbaasDefaultPorts contains ports that are used for datastore connections
+// without an explicit port.
+var dbaasDefaultPorts = map[string]int{
+	"postgresql": 5433,
+	"mysql":      6033,
+}
+
+type dbaasConnectionURIOpts struct {
+	engine      string
+	format      string
+	port        int
+	user        string
+	password    string
+	database    string
+	sslMode     string
+	sslRootCert string
+}
+
+// buildDBaaSConnectionURIs builds a connection URI for every datastore connection.
+func buildDBaaSConnectionURIs(connections map[string]string, opts dbaasConnectionURIOpts) map[string]string {
+	uris := make(map[string]string, len(connections))
+	for name, host := range connections {
+		if host == "" {
+			continue
+		}
+		uris[name] = buildDBaaSConnectionURI(host, opts)
+	}
+
+	return uris
+}
+
+// buildDBaaSConnectionURI builds a libpq or MySQL style URI or a JDBC URL
+// for the host. Ports that are part of the host take precedence over opts.port.
+func buildDBaaSConnectionURI(host string, opts dbaasConnectionURIOpts) string {
+	port := opts.port
+	if port == 0 {
+		port = dbaasDefaultPorts[opts.engine]
+	}
+	if h, p, err := net.SplitHostPort(host); err == nil {
+		host = h
+		if portNumber, err := strconv.Atoi(p); err == nil {
+			port = portNumber
+		}
+	}
+
+	connectionURL := url.URL{
+		Scheme: opts.engine,
+		Host:   net.JoinHostPort(host, strconv.Itoa(port)),
+		Path:   "/" + opts.database,
+	}
+	query := url.Values{}
+
+	sslModeKey, sslRootCertKey := "sslmode", "sslrootcert"
+	if opts.engine == "mysql" {
+		sslModeKey, sslRootCertKey = "ssl-mode", "ssl-ca"
+	}
+
+	if opts.format == dbaasConnectionURIFormatJDBC {
+		if opts.user != "" {
+			query.Set("user", opts.user)
+		}
+		if opts.password != "" {
+			query.Set("password", opts.password)
+		}
+		if opts.engine == "mysql" {
+			// Connector/J expects a CA in a trust store, so only sslMode is passed.
+			sslModeKey, sslRootCertKey = "sslMode", ""
+		}
+	} else if opts.user != "" {
+		connectionURL.User = url.User(opts.user)
+		if opts.password != "" {
+			connectionURL.User = url.UserPassword(opts.user, opts.password)
+		}
+	}
+
+	if opts.sslMode != "" {
+		query.Set(sslModeKey, opts.sslMode)
+	}
+	if opts.sslRootCert != "" && sslRootCertKey != "" {
+		query.Set(sslRootCertKey, opts.sslRootCert)
+	}
+	connectionURL.RawQuery = query.Encode()
+
+	if opts.format == dbaasConnectionURIFormatJDBC {
+		return "jdbc:" + connectionURL.String()
+	}
+
+	return connectionURL.String()
+}
+
+// setDBaaSDatabaseConnectionURI sets URIs of the database for the datastore connections.
+// Passwords aren't returned by the API, so URIs contain only the owner name.
+func setDBaaSDatabaseConnectionURI(ctx context.Context, d *schema.ResourceData, client *dbaas.API, engine string, database dbaas.Database) {
+	datastore, err := client.Datastore(ctx, database.DatastoreID)
+	if err != nil {
+		log.Printf("[DEBUG] %s", errGettingObject(objectDatastore, database.DatastoreID, err))
+		return
+	}
+
+	opts := dbaasConnectionURIOpts{
+		engine:   engine,
+		format:   dbaasConnectionURIFormatURI,
+		database: database.Name,
+	}
+	if database.OwnerID != "" {
+		owner, err := client.User(ctx, database.OwnerID)
+		if err != nil {
+			log.Printf("[DEBUG] %s", errGettingObject(objectUser, database.OwnerID, err))
+		} else {
+			opts.user = owner.Name
+		}
+	}
+
+	if err := d.Set("connection_uri", buildDBaaSConnectionURIs(datastore.Connection, opts)); err != nil {
+		log.Print(errSettingComplexAttr("connection_uri", err))
+	}
+}
@@ -8,9 +8,9 @@ import (
 	"github.com/selectel/dbaas-go"
 )
 
-func updateRedisDatastorePassword(ctx context.Context, d *schema.ResourceData, client *dbaas.API) error {
+func updateRedisDatastorePassword(ctx context.Context, d *schema.ResourceData, client *dbaas.API, redisPassword string) error {
 	passwordOpts := dbaas.DatastorePasswordOpts{
-		RedisPassword: d.Get("redis_password").(string),
+		RedisPassword: redisPassword,
 	}
 
 	log.Print(msgUpdate(objectDatastore, d.Id(), passwordOpts))
@@ -28,29 +28,3 @@ func updateRedisDatastorePassword(ctx context.Context, d *schema.ResourceData, c
 
 	return nil
 }
-
-func resizeRedisDatastore(ctx context.Context, d *schema.ResourceData, client *dbaas.API) error {
-	var resizeOpts dbaas.DatastoreResizeOpts
-	nodeCount := d.Get("node_count").(int)
-	resizeOpts.NodeCount = nodeCount
-
-	flavorID := d.Get("flavor_id")
-
-	resizeOpts.Flavor = nil
-	resizeOpts.FlavorID = flavorID.(string)
-
-	log.Print(msgUpdate(objectDatastore, d.Id(), resizeOpts))
-	_, err := client.ResizeDatastore(ctx, d.Id(), resizeOpts)
-	if err != nil {
-		return errUpdatingObject(objectDatastore, d.Id(), err)
-	}
-
-	log.Printf("[DEBUG] waiting for datastore %s to become 'ACTIVE'", d.Id())
-	timeout := d.Timeout(schema.TimeoutCreate)
-	err = waitForDBaaSDatastoreV1ActiveState(ctx, client, d.Id(), timeout)
-	if err != nil {
-		return errUpdatingObject(objectDatastore, d.Id(), err)
-	}
-
-	return nil
-}
@@ -1,8 +1,14 @@
 package selectel
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/selectel/dbaas-go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,3 +27,458 @@ func TestGetDBaaSDatastoreV1Endpoint(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	}
 }
+
+func TestValidateDatastoreConfigParameter(t *testing.T) {
+	tableTest := []struct {
+		param    dbaas.ConfigurationParameter
+		value    string
+		expected error
+	}{
+		{
+			param: dbaas.ConfigurationParameter{Name: "work_mem", Type: "int", Min: float64(64), Max: float64(2147483647), IsChangeable: true},
+			value: "512",
+		},
+		{
+			param:    dbaas.ConfigurationParameter{Name: "work_mem", Type: "int", Min: float64(64), Max: float64(2147483647), IsChangeable: true},
+			value:    "32",
+			expected: errors.New("32 is less than the minimum value 64"),
+		},
+		{
+			param:    dbaas.ConfigurationParameter{Name: "work_mem", Type: "int", Min: float64(64), Max: float64(1024), IsChangeable: true},
+			value:    "2048",
+			expected: errors.New("2048 is greater than the maximum value 1024"),
+		},
+		{
+			param:    dbaas.ConfigurationParameter{Name: "work_mem", Type: "int", IsChangeable: true},
+			value:    "512MB",
+			expected: errors.New("expected an integer, got '512MB'"),
+		},
+		{
+			param: dbaas.ConfigurationParameter{Name: "vacuum_cost_delay", Type: "float", Min: float64(0), Max: float64(100), IsChangeable: true},
+			value: "0.5",
+		},
+		{
+			param:    dbaas.ConfigurationParameter{Name: "transform_null_equals", Type: "bool", IsChangeable: true},
+			value:    "yes",
+			expected: errors.New("expected a boolean, got 'yes'"),
+		},
+		{
+			param: dbaas.ConfigurationParameter{
+				Name: "session_replication_role", Type: "str", IsChangeable: true,
+				Choices: []interface{}{"origin", "replica", "local"},
+			},
+			value: "replica",
+		},
+		{
+			param: dbaas.ConfigurationParameter{
+				Name: "session_replication_role", Type: "str", IsChangeable: true,
+				Choices: []interface{}{"origin", "replica", "local"},
+			},
+			value:    "master",
+			expected: errors.New("'master' is not one of [origin, replica, local]"),
+		},
+		{
+			param:    dbaas.ConfigurationParameter{Name: "shared_buffers", Type: "int", IsChangeable: false},
+			value:    "1024",
+			expected: errors.New("parameter can't be changed"),
+		},
+	}
+
+	for _, test := range tableTest {
+		actual := validateDatastoreConfigParameter(test.param, test.value)
+		assert.Equal(t, test.expected, actual)
+	}
+}
+
+func TestValidateDatastoreConfig(t *testing.T) {
+	configurationParameters := []dbaas.ConfigurationParameter{
+		{Name: "work_mem", Type: "int", Min: float64(64), IsChangeable: true},
+		{Name: "max_connections", Type: "int", IsChangeable: true, IsRestartRequired: true},
+	}
+
+	err := validateDatastoreConfig("a1b2c3", map[string]string{
+		"work_mem":        "512",
+		"max_connections": "200",
+	}, configurationParameters)
+	assert.NoError(t, err)
+
+	err = validateDatastoreConfig("a1b2c3", map[string]string{
+		"work_mme": "512",
+		"work_mem": "1",
+	}, configurationParameters)
+	assert.EqualError(t, err, "invalid value of configuration parameter 'work_mem': 1 is less than the minimum value 64\n"+
+		"unknown configuration parameter 'work_mme' for the datastore type 'a1b2c3'")
+}
+
+func TestRestartRequiredDatastoreConfigParams(t *testing.T) {
+	configurationParameters := []dbaas.ConfigurationParameter{
+		{Name: "work_mem", Type: "int", IsChangeable: true},
+		{Name: "max_connections", Type: "int", IsChangeable: true, IsRestartRequired: true},
+		{Name: "shared_buffers", Type: "int", IsChangeable: true, IsRestartRequired: true},
+		{Name: "wal_level", Type: "str", IsChangeable: true, IsRestartRequired: true},
+	}
+
+	oldConfig := map[string]interface{}{
+		"work_mem":        "64",
+		"max_connections": "100",
+		"shared_buffers":  "128",
+	}
+	newConfig := map[string]interface{}{
+		"work_mem":        "128",
+		"max_connections": "200",
+		"shared_buffers":  "128.0",
+		"wal_level":       "logical",
+	}
+
+	actual := restartRequiredDatastoreConfigParams(oldConfig, newConfig, configurationParameters)
+	assert.Equal(t, []string{"max_connections", "wal_level"}, actual)
+
+	actual = restartRequiredDatastoreConfigParams(oldConfig, map[string]interface{}{}, configurationParameters)
+	assert.Equal(t, []string{"max_connections", "shared_buffers"}, actual)
+
+	actual = restartRequiredDatastoreConfigParams(oldConfig, oldConfig, configurationParameters)
+	assert.Empty(t, actual)
+}
+
+func TestDatastoreConfigValuesEqual(t *testing.T) {
+	tableTest := []struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		{old: "content", new: "content", expected: true},
+		{old: "0.5", new: "0.50", expected: true},
+		{old: "512", new: "512.0", expected: true},
+		{old: "true", new: "1", expected: true},
+		{old: "false", new: "f", expected: true},
+		{old: "0.5", new: "0.6", expected: false},
+		{old: "true", new: "false", expected: false},
+		{old: "content", new: "document", expected: false},
+		{old: "512", new: "", expected: false},
+	}
+
+	for _, test := range tableTest {
+		actual := datastoreConfigValuesEqual(test.old, test.new)
+		assert.Equal(t, test.expected, actual, "%s and %s", test.old, test.new)
+	}
+}
+
+func TestFlattenDatastoreConfigDatastoreV1(t *testing.T) {
+	// The deprecated datastore resource has no config_managed_keys_only attribute.
+	d := schema.TestResourceDataRaw(t, resourceDBaaSDatastoreV1().Schema, map[string]interface{}{
+		"config": map[string]interface{}{"xmin": "1"},
+	})
+
+	actual := flattenDatastoreConfig(d, map[string]interface{}{"xmin": true, "work_mem": 512.0})
+
+	assert.Equal(t, map[string]string{"xmin": "true", "work_mem": "512"}, actual)
+	assert.NotNil(t, resourceDBaaSDatastoreV1().Schema["config"].DiffSuppressFunc)
+}
+
+func TestConvertDatastoreConfigValuesByType(t *testing.T) {
+	configurationParameters := []dbaas.ConfigurationParameter{
+		{Name: "work_mem", Type: "int"},
+		{Name: "vacuum_cost_delay", Type: "float"},
+		{Name: "transform_null_equals", Type: "bool"},
+		{Name: "xmloption", Type: "str"},
+	}
+	config := map[string]interface{}{
+		"work_mem":              "512",
+		"vacuum_cost_delay":     "0.50",
+		"transform_null_equals": "1",
+		"xmloption":             "content",
+		"unknown_param":         "value",
+		"reset_param":           nil,
+	}
+
+	expected := map[string]interface{}{
+		"work_mem":              512,
+		"vacuum_cost_delay":     0.5,
+		"transform_null_equals": true,
+		"xmloption":             "content",
+		"unknown_param":         "value",
+		"reset_param":           nil,
+	}
+
+	actual := convertDatastoreConfigValuesByType(config, configurationParameters)
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestDatastoreConfigManagedKeysOnlyTurnedOn(t *testing.T) {
+	tableTest := []struct {
+		name           string
+		config         map[string]interface{}
+		rawConfig      cty.Value
+		expectedConfig map[string]interface{}
+	}{
+		{
+			name:           "config with some of the parameters",
+			config:         map[string]interface{}{"max_connections": "100"},
+			rawConfig:      cty.MapVal(map[string]cty.Value{"max_connections": cty.StringVal("100")}),
+			expectedConfig: map[string]interface{}{"max_connections": "100"},
+		},
+		{
+			name:           "omitted config",
+			rawConfig:      cty.NullVal(cty.Map(cty.String)),
+			expectedConfig: map[string]interface{}{},
+		},
+	}
+
+	for _, test := range tableTest {
+		t.Run(test.name, func(t *testing.T) {
+			resource := resourceDBaaSPostgreSQLDatastoreV1()
+			// The state is refreshed with the mode turned off, so it holds every parameter.
+			state := &terraform.InstanceState{
+				ID: "a1b2c3",
+				Attributes: map[string]string{
+					"id":                       "a1b2c3",
+					"name":                     "datastore",
+					"project_id":               "d4e5f6",
+					"region":                   ru1Region,
+					"subnet_id":                "g7h8i9",
+					"type_id":                  "pg-14",
+					"flavor_id":                "4-16-64",
+					"node_count":               "1",
+					"config.%":                 "3",
+					"config.max_connections":   "100",
+					"config.work_mem":          "4096",
+					"config.autovacuum":        "true",
+					"config_managed_keys_only": "false",
+				},
+				RawConfig: cty.ObjectVal(map[string]cty.Value{"config": test.rawConfig}),
+			}
+			config := map[string]interface{}{
+				"name":                     "datastore",
+				"project_id":               "d4e5f6",
+				"region":                   ru1Region,
+				"subnet_id":                "g7h8i9",
+				"type_id":                  "pg-14",
+				"flavor_id":                "4-16-64",
+				"node_count":               1,
+				"config_managed_keys_only": true,
+			}
+			if test.config != nil {
+				config["config"] = test.config
+			}
+
+			diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+			assert.NoError(t, err)
+
+			d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedConfig, d.Get("config"))
+			assert.Empty(t, datastoreConfigResetParams(d, map[string]interface{}{
+				"max_connections": 100,
+				"work_mem":        4096,
+				"autovacuum":      true,
+			}))
+		})
+	}
+}
+
+func TestDatastoreConfigResetParams(t *testing.T) {
+	datastoreConfig := map[string]interface{}{"max_connections": 100, "work_mem": 4096}
+
+	d := schema.TestResourceDataRaw(t, resourceDBaaSPostgreSQLDatastoreV1().Schema, map[string]interface{}{})
+	assert.Equal(t, datastoreConfig, datastoreConfigResetParams(d, datastoreConfig))
+
+	resource := resourceDBaaSPostgreSQLDatastoreV1()
+	state := &terraform.InstanceState{
+		ID: "a1b2c3",
+		Attributes: map[string]string{
+			"id":                       "a1b2c3",
+			"config.%":                 "1",
+			"config.max_connections":   "100",
+			"config_managed_keys_only": "true",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"config.%":               {Old: "1", New: "0"},
+			"config.max_connections": {Old: "100", NewRemoved: true},
+		},
+	}
+	d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"max_connections": "100"}, datastoreConfigResetParams(d, datastoreConfig))
+}
+
+func TestGenerateDBaaSPassword(t *testing.T) {
+	password, err := generateDBaaSPassword()
+	assert.NoError(t, err)
+	assert.Len(t, password, dbaasGeneratedPasswordLength)
+	for _, char := range password {
+		assert.Contains(t, dbaasGeneratedPasswordChars, string(char))
+	}
+
+	anotherPassword, err := generateDBaaSPassword()
+	assert.NoError(t, err)
+	assert.NotEqual(t, password, anotherPassword)
+}
+
+func TestGetDBaaSPasswordDatastoreV1(t *testing.T) {
+	// The deprecated datastore resource has no generate_password attribute.
+	d := schema.TestResourceDataRaw(t, resourceDBaaSDatastoreV1().Schema, map[string]interface{}{
+		"redis_password": "secret",
+	})
+
+	assert.NotPanics(t, func() {
+		password, err := getDBaaSPassword(d, "redis_password")
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", password)
+		assert.True(t, dbaasPasswordHasChange(d, "redis_password"))
+	})
+}
+
+func TestDBaaSPasswordCustomizeDiff(t *testing.T) {
+	tableTest := []struct {
+		name   string
+		state  map[string]string
+		config map[string]interface{}
+		err    error
+	}{
+		{
+			name:   "password",
+			config: map[string]interface{}{"password": "secret"},
+		},
+		{
+			name:   "password with generate_password disabled",
+			config: map[string]interface{}{"password": "secret", "generate_password": false},
+		},
+		{
+			name:   "generate_password",
+			config: map[string]interface{}{"generate_password": true},
+		},
+		{
+			name:   "neither password nor generate_password",
+			config: map[string]interface{}{},
+			err:    errDBaaSPasswordRequired("password"),
+		},
+		{
+			name:   "both password and generate_password",
+			config: map[string]interface{}{"password": "secret", "generate_password": true},
+			err:    errDBaaSPasswordConflict("password"),
+		},
+		{
+			name:   "password removed from an existing user",
+			state:  map[string]string{"id": "123", "password": "secret"},
+			config: map[string]interface{}{},
+			err:    errDBaaSPasswordRequired("password"),
+		},
+	}
+
+	for _, test := range tableTest {
+		t.Run(test.name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"datastore_id": "a1b2c3",
+				"region":       ru1Region,
+				"project_id":   "d4e5f6",
+				"name":         "user",
+			}
+			for k, v := range test.config {
+				config[k] = v
+			}
+
+			var state *terraform.InstanceState
+			if test.state != nil {
+				state = &terraform.InstanceState{ID: test.state["id"], Attributes: test.state}
+			}
+
+			_, err := resourceDBaaSUserV1().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+			if test.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.err.Error())
+			}
+		})
+	}
+}
+
+func TestFindDatastoreType(t *testing.T) {
+	datastoreTypes := []dbaas.DatastoreType{
+		{ID: "pg-12", Engine: "postgresql", Version: "12"},
+		{ID: "pg-9.6", Engine: "postgresql", Version: "9.6"},
+		{ID: "pg-14", Engine: "postgresql", Version: "14"},
+		{ID: "mysql-8", Engine: "mysql", Version: "8"},
+		{ID: "redis-6", Engine: "redis", Version: "6"},
+	}
+
+	tableTest := []struct {
+		engine     string
+		version    string
+		expectedID string
+		err        error
+	}{
+		{engine: "postgresql", version: "12", expectedID: "pg-12"},
+		{engine: "postgresql", version: "latest", expectedID: "pg-14"},
+		{engine: "mysql", version: "latest", expectedID: "mysql-8"},
+		{
+			engine:  "postgresql",
+			version: "8",
+			err:     errors.New("datastore type with engine postgresql and version 8 is not found"),
+		},
+		{
+			engine:  "kafka",
+			version: "latest",
+			err:     errors.New("datastore type with engine kafka and version latest is not found"),
+		},
+	}
+
+	for _, test := range tableTest {
+		actual, err := findDatastoreType(datastoreTypes, test.engine, test.version)
+		assert.Equal(t, test.err, err)
+		assert.Equal(t, test.expectedID, actual.ID)
+	}
+}
+
+func TestDBaaSDatastoreV1TypeIDOrEngineVersion(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"postgresql": resourceDBaaSPostgreSQLDatastoreV1(),
+		"mysql":      resourceDBaaSMySQLDatastoreV1(),
+		"redis":      resourceDBaaSRedisDatastoreV1(),
+		"kafka":      resourceDBaaSKafkaDatastoreV1(),
+	}
+
+	for engine, r := range resources {
+		config := map[string]interface{}{
+			"name":       "datastore",
+			"subnet_id":  "a1b2c3",
+			"project_id": "d4e5f6",
+			"region":     ru1Region,
+			"node_count": 1,
+			"flavor_id":  "g7h8i9",
+		}
+		diags := r.Validate(terraform.NewResourceConfigRaw(config))
+		var details []string
+		for _, d := range diags {
+			details = append(details, d.Detail)
+		}
+		assert.Contains(t, details, "\"engine_version\": one of `engine_version,type_id` must be specified", engine)
+
+		config["engine_version"] = "latest"
+		diags = r.Validate(terraform.NewResourceConfigRaw(config))
+		for _, d := range diags {
+			assert.NotContains(t, d.Summary+d.Detail, "engine_version", engine)
+		}
+	}
+}
+
+func TestCompareDatastoreTypeVersions(t *testing.T) {
+	tableTest := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "12", b: "12", expected: 0},
+		{a: "9.6", b: "12", expected: -1},
+		{a: "14", b: "12", expected: 1},
+		{a: "8.0", b: "8", expected: 1},
+		{a: "5.7", b: "8.0", expected: -1},
+	}
+
+	for _, test := range tableTest {
+		actual := compareDatastoreTypeVersions(test.a, test.b)
+		assert.Equal(t, test.expected, actual, "%s and %s", test.a, test.b)
+	}
+}
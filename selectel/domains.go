@@ -1,9 +1,19 @@
 package selectel
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "github.com/selectel/domains-go/pkg/v1"
+	"github.com/selectel/domains-go/pkg/v1/domain"
+	"github.com/selectel/domains-go/pkg/v1/record"
 )
 
 const (
@@ -26,6 +36,17 @@ const (
 	TypeRecordALIAS string = "ALIAS"
 )
 
+// domainsV1RecordContentOnlyTypes contains record types that only have content,
+// so records can be changed between them in place.
+var domainsV1RecordContentOnlyTypes = map[string]bool{
+	TypeRecordA:     true,
+	TypeRecordAAAA:  true,
+	TypeRecordTXT:   true,
+	TypeRecordCNAME: true,
+	TypeRecordNS:    true,
+	TypeRecordALIAS: true,
+}
+
 func domainsV1ParseDomainRecordIDsPair(id string) (int, int, error) {
 	parts := strings.Split(id, "/")
 	if len(parts) != 2 {
@@ -48,6 +69,340 @@ func domainsV1ParseDomainRecordIDsPair(id string) (int, int, error) {
 	return domainID, recordID, nil
 }
 
+func domainsV1ParseRRSetID(id string) (int, string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return -1, "", "", errParseDomainsRRSetV1ID(id)
+	}
+
+	domainID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return -1, "", "", errParseDomainsDomainV1ID(parts[0])
+	}
+
+	return domainID, parts[1], strings.ToUpper(parts[2]), nil
+}
+
+// domainsV1RecordNamesEqual compares record names case-insensitively
+// and ignores the trailing dot of fully qualified names.
+func domainsV1RecordNamesEqual(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+// domainsV1RecordRData returns the record data in the zone file presentation format
+// without the name, the TTL and the type, e.g. "10 mail.example.org" for MX records.
+// TXT records are returned as is, without quotes.
+func domainsV1RecordRData(r *record.View) string {
+	switch string(r.Type) {
+	case TypeRecordMX:
+		return fmt.Sprintf("%d %s", intValue(r.Priority), r.Content)
+	case TypeRecordSRV:
+		return fmt.Sprintf("%d %d %d %s", intValue(r.Priority), intValue(r.Weight), intValue(r.Port), r.Target)
+	case TypeRecordCAA:
+		return fmt.Sprintf(`%d %s "%s"`, intValue(r.Flag), r.Tag, r.Value)
+	case TypeRecordSSHFP:
+		return fmt.Sprintf("%d %d %s", intValue(r.Algorithm), intValue(r.FingerprintType), r.Fingerprint)
+	default:
+		return r.Content
+	}
+}
+
+// domainsV1RecordOptsFromRData parses the record data returned by domainsV1RecordRData.
+// SOA records are managed by the service and aren't supported.
+func domainsV1RecordOptsFromRData(recordType, rdata string) (*record.CreateOpts, error) {
+	opts := &record.CreateOpts{
+		Type: record.Type(recordType),
+	}
+	fields := strings.Fields(rdata)
+
+	var err error
+	switch recordType {
+	case TypeRecordA, TypeRecordAAAA, TypeRecordCNAME, TypeRecordNS, TypeRecordALIAS:
+		if len(fields) != 1 {
+			return nil, errParseDomainsRecordV1RData(recordType, rdata)
+		}
+		opts.Content = fields[0]
+	case TypeRecordTXT:
+		if strings.TrimSpace(rdata) == "" {
+			return nil, errParseDomainsRecordV1RData(recordType, rdata)
+		}
+		opts.Content = rdata
+	case TypeRecordMX:
+		if len(fields) != 2 {
+			return nil, errParseDomainsRecordV1RData(recordType, rdata)
+		}
+		opts.Priority, err = domainsV1ParseRDataInt(fields[0])
+		opts.Content = fields[1]
+	case TypeRecordSRV:
+		if len(fields) != 4 {
+			return nil, errParseDomainsRecordV1RData(recordType, rdata)
+		}
+		opts.Priority, err = domainsV1ParseRDataInt(fields[0])
+		if err == nil {
+			opts.Weight, err = domainsV1ParseRDataInt(fields[1])
+		}
+		if err == nil {
+			opts.Port, err = domainsV1ParseRDataInt(fields[2])
+		}
+		opts.Target = fields[3]
+	case TypeRecordCAA:
+		if len(fields) < 3 {
+			return nil, errParseDomainsRecordV1RData(recordType, rdata)
+		}
+		opts.Flag, err = domainsV1ParseRDataInt(fields[0])
+		opts.Tag = fields[1]
+		opts.Value = strings.Trim(strings.Join(fields[2:], " "), `"`)
+	case TypeRecordSSHFP:
+		if len(fields) != 3 {
+			return nil, errParseDomainsRecordV1RData(recordType, rdata)
+		}
+		opts.Algorithm, err = domainsV1ParseRDataInt(fields[0])
+		if err == nil {
+			opts.FingerprintType, err = domainsV1ParseRDataInt(fields[1])
+		}
+		opts.Fingerprint = fields[2]
+	default:
+		return nil, errParseDomainsRecordV1RData(recordType, rdata)
+	}
+	if err != nil {
+		return nil, errParseDomainsRecordV1RData(recordType, rdata)
+	}
+
+	return opts, nil
+}
+
+// domainsV1RecordViewFromOpts returns the record that is created with the opts.
+func domainsV1RecordViewFromOpts(opts *record.CreateOpts) *record.View {
+	return &record.View{
+		Name:            opts.Name,
+		Type:            opts.Type,
+		TTL:             opts.TTL,
+		Content:         opts.Content,
+		Email:           opts.Email,
+		Priority:        opts.Priority,
+		Weight:          opts.Weight,
+		Port:            opts.Port,
+		Target:          opts.Target,
+		Tag:             opts.Tag,
+		Flag:            opts.Flag,
+		Value:           opts.Value,
+		Algorithm:       opts.Algorithm,
+		FingerprintType: opts.FingerprintType,
+		Fingerprint:     opts.Fingerprint,
+	}
+}
+
+func domainsV1RecordSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"weight": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flag": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"algorithm": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fingerprint_type": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rdata": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func flattenDomainsV1Records(records []*record.View) []interface{} {
+	recordsList := make([]interface{}, len(records))
+	for i, r := range records {
+		recordsList[i] = map[string]interface{}{
+			"id":               r.ID,
+			"name":             r.Name,
+			"type":             string(r.Type),
+			"ttl":              r.TTL,
+			"content":          r.Content,
+			"email":            r.Email,
+			"priority":         intValue(r.Priority),
+			"weight":           intValue(r.Weight),
+			"port":             intValue(r.Port),
+			"target":           r.Target,
+			"tag":              r.Tag,
+			"flag":             intValue(r.Flag),
+			"value":            r.Value,
+			"algorithm":        intValue(r.Algorithm),
+			"fingerprint_type": intValue(r.FingerprintType),
+			"fingerprint":      r.Fingerprint,
+			"rdata":            domainsV1RecordRData(r),
+		}
+	}
+
+	return recordsList
+}
+
+func expandDomainsV1RRSetRecords(recordsSet *schema.Set) []string {
+	records := make([]string, 0, recordsSet.Len())
+	for _, r := range recordsSet.List() {
+		records = append(records, r.(string))
+	}
+	sort.Strings(records)
+
+	return records
+}
+
+// domainsV1CreateRecord creates the record holding the domain lock, so records
+// of the same domain are never created or deleted concurrently.
+func domainsV1CreateRecord(ctx context.Context, client *v1.ServiceClient, domainID int, opts *record.CreateOpts) (*record.View, error) {
+	selMutexKV.Lock(strconv.Itoa(domainID))
+	defer selMutexKV.Unlock(strconv.Itoa(domainID))
+
+	log.Print(msgCreate(objectRecord, opts))
+	recordObj, _, err := record.Create(ctx, client, domainID, opts)
+
+	return recordObj, err
+}
+
+func domainsV1DeleteRecord(ctx context.Context, client *v1.ServiceClient, domainID, recordID int) (*v1.ResponseResult, error) {
+	selMutexKV.Lock(strconv.Itoa(domainID))
+	defer selMutexKV.Unlock(strconv.Itoa(domainID))
+
+	log.Print(msgDelete(objectRecord, fmt.Sprintf("%d/%d", domainID, recordID)))
+
+	return record.Delete(ctx, client, domainID, recordID)
+}
+
+// domainsV1ACMEChallengeName returns the name of the DNS-01 challenge record for the FQDN.
+// Wildcard certificates are validated with the record of the base domain.
+func domainsV1ACMEChallengeName(fqdn string) string {
+	name := strings.TrimPrefix(strings.TrimSuffix(fqdn, "."), "*.")
+
+	return "_acme-challenge." + strings.ToLower(name)
+}
+
+// domainsV1MatchDomain returns the domain that the name belongs to. When domains are
+// nested, e.g. example.org and dev.example.org, the most specific one is returned.
+func domainsV1MatchDomain(domains []*domain.View, name string) *domain.View {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+
+	var matched *domain.View
+	for _, d := range domains {
+		domainName := strings.ToLower(strings.TrimSuffix(d.Name, "."))
+		if name != domainName && !strings.HasSuffix(name, "."+domainName) {
+			continue
+		}
+		if matched == nil || len(d.Name) > len(matched.Name) {
+			matched = d
+		}
+	}
+
+	return matched
+}
+
+// domainsV1NameserverAddress adds the default DNS port to the nameserver
+// if it doesn't have one.
+func domainsV1NameserverAddress(nameserver string) string {
+	if _, _, err := net.SplitHostPort(nameserver); err == nil {
+		return nameserver
+	}
+
+	return net.JoinHostPort(strings.TrimSuffix(nameserver, "."), "53")
+}
+
+// domainsV1LookupTXT queries the TXT records of the name directly from the nameserver,
+// bypassing the system resolver and its caches.
+func domainsV1LookupTXT(ctx context.Context, nameserver, name string) ([]string, error) {
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, nameserver)
+		},
+	}
+
+	return resolver.LookupTXT(ctx, domainsV1ZoneFQDN(name))
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func domainsV1ParseRDataInt(field string) (*int, error) {
+	v, err := strconv.Atoi(field)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+func intValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
+
 func getIntPtrOrNil(v interface{}) *int {
 	if v == nil {
 		return nil
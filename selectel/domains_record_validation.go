@@ -0,0 +1,268 @@
+package selectel
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/domains-go/pkg/v1/record"
+)
+
+const (
+	// domainsV1TXTStringMaxLength is the limit of a single TXT character string.
+	domainsV1TXTStringMaxLength = 255
+
+	// domainsV1TXTMaxLength is the limit of the TXT record data where every
+	// character string also takes a length byte.
+	domainsV1TXTMaxLength = 65535
+
+	domainsV1HostnameMaxLength = 253
+)
+
+var (
+	domainsV1HostnameLabelRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?$`)
+	domainsV1CAATagRegexp        = regexp.MustCompile(`^[a-zA-Z0-9]{1,15}$`)
+	domainsV1SSHFPLengths        = map[int]int{
+		1: 40, // SHA-1
+		2: 64, // SHA-256
+	}
+)
+
+// domainsV1ValidateRecordOpts checks that the record data is valid for the record type.
+func domainsV1ValidateRecordOpts(opts *record.CreateOpts) error {
+	var err error
+
+	switch string(opts.Type) {
+	case TypeRecordA:
+		if ip := net.ParseIP(opts.Content); ip == nil || ip.To4() == nil || strings.Contains(opts.Content, ":") {
+			err = fmt.Errorf("content '%s' is not a valid IPv4 address", opts.Content)
+		}
+	case TypeRecordAAAA:
+		if ip := net.ParseIP(opts.Content); ip == nil || !strings.Contains(opts.Content, ":") {
+			err = fmt.Errorf("content '%s' is not a valid IPv6 address", opts.Content)
+		}
+	case TypeRecordTXT:
+		err = domainsV1ValidateTXTContent(opts.Content)
+	case TypeRecordCNAME, TypeRecordNS, TypeRecordALIAS:
+		err = domainsV1ValidateHostname("content", opts.Content)
+	case TypeRecordMX:
+		// The root mail exchange means that the domain doesn't accept mail.
+		if opts.Content != "." {
+			err = domainsV1ValidateHostname("content", opts.Content)
+		}
+	case TypeRecordSOA:
+		err = domainsV1ValidateHostname("content", opts.Content)
+		if err == nil && opts.Email == "" {
+			err = errors.New("email is required")
+		}
+	case TypeRecordSRV:
+		// The root target means that the service is not available at the domain.
+		if opts.Target != "." {
+			err = domainsV1ValidateHostname("target", opts.Target)
+		}
+	case TypeRecordCAA:
+		err = domainsV1ValidateCAA(opts.Tag, opts.Value)
+	case TypeRecordSSHFP:
+		err = domainsV1ValidateSSHFP(intValue(opts.FingerprintType), opts.Fingerprint)
+	}
+
+	if err != nil {
+		return errValidateDomainsRecordV1(string(opts.Type), err)
+	}
+
+	return nil
+}
+
+// domainsV1ValidateRecordConflicts checks the record against other records of the domain:
+// CNAME records can't be created at the zone apex and can't coexist with other records
+// of the same name.
+func domainsV1ValidateRecordConflicts(domainName string, recordID int, opts *record.CreateOpts, records []*record.View) error {
+	isCNAME := string(opts.Type) == TypeRecordCNAME
+	if isCNAME && domainsV1RecordNamesEqual(opts.Name, domainName) {
+		return errValidateDomainsRecordV1(TypeRecordCNAME, fmt.Errorf("can't be created at the zone apex '%s'", domainName))
+	}
+
+	for _, r := range records {
+		if r.ID == recordID || !domainsV1RecordNamesEqual(r.Name, opts.Name) {
+			continue
+		}
+		if isCNAME || string(r.Type) == TypeRecordCNAME {
+			return errValidateDomainsRecordV1(string(opts.Type),
+				fmt.Errorf("name '%s' already has a %s record with ID %d and CNAME records can't coexist with other records",
+					opts.Name, r.Type, r.ID))
+		}
+	}
+
+	return nil
+}
+
+func domainsV1ValidateHostname(field, hostname string) error {
+	if hostname == "" {
+		return fmt.Errorf("%s is required", field)
+	}
+
+	name := strings.TrimSuffix(hostname, ".")
+	if len(name) > domainsV1HostnameMaxLength {
+		return fmt.Errorf("%s '%s' is longer than %d characters", field, hostname, domainsV1HostnameMaxLength)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if !domainsV1HostnameLabelRegexp.MatchString(label) {
+			return fmt.Errorf("%s '%s' is not a valid hostname", field, hostname)
+		}
+	}
+
+	return nil
+}
+
+func domainsV1ValidateTXTContent(content string) error {
+	if content == "" {
+		return errors.New("content is required")
+	}
+
+	strs, ok := domainsV1ParseTXTStrings(content)
+	if ok {
+		for i, s := range strs {
+			if len(s) > domainsV1TXTStringMaxLength {
+				return fmt.Errorf("string %d of the content is longer than %d bytes", i+1, domainsV1TXTStringMaxLength)
+			}
+		}
+	} else {
+		strs = domainsV1SplitTXTStrings(content)
+	}
+
+	var length int
+	for _, s := range strs {
+		length += len(s) + 1
+	}
+	if length > domainsV1TXTMaxLength {
+		return fmt.Errorf("content is longer than %d bytes", domainsV1TXTMaxLength)
+	}
+
+	return nil
+}
+
+func domainsV1ValidateCAA(tag, value string) error {
+	if !domainsV1CAATagRegexp.MatchString(tag) {
+		return fmt.Errorf("tag '%s' must consist of 1 to 15 letters and digits", tag)
+	}
+
+	switch tag {
+	case "issue", "issuewild":
+		// The value is an issuer domain name followed by optional parameters,
+		// an empty issuer forbids the issuance.
+		issuer := strings.TrimSpace(strings.SplitN(value, ";", 2)[0])
+		if issuer == "" {
+			return nil
+		}
+		if err := domainsV1ValidateHostname("value", issuer); err != nil {
+			return err
+		}
+	case "iodef":
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "mailto" && u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("value '%s' of the iodef tag must be a mailto, http or https URL", value)
+		}
+	}
+
+	return nil
+}
+
+func domainsV1ValidateSSHFP(fingerprintType int, fingerprint string) error {
+	if fingerprint == "" {
+		return errors.New("fingerprint is required")
+	}
+
+	if length, ok := domainsV1SSHFPLengths[fingerprintType]; ok && len(fingerprint) != length {
+		return fmt.Errorf("fingerprint of type %d must be %d hexadecimal characters long, got %d",
+			fingerprintType, length, len(fingerprint))
+	}
+
+	return nil
+}
+
+// domainsV1ParseTXTStrings parses the TXT content that is already written as
+// a sequence of quoted character strings, e.g. `"v=DKIM1; p=..." "..."`.
+// It returns false if the content is a plain text.
+func domainsV1ParseTXTStrings(content string) ([]string, bool) {
+	rest := strings.TrimSpace(content)
+	if !strings.HasPrefix(rest, `"`) {
+		return nil, false
+	}
+
+	var strs []string
+	for rest != "" {
+		if rest[0] != '"' {
+			return nil, false
+		}
+
+		var (
+			value  strings.Builder
+			closed bool
+			i      = 1
+		)
+		for ; i < len(rest); i++ {
+			if rest[i] == '\\' && i+1 < len(rest) {
+				i++
+				value.WriteByte(rest[i])
+				continue
+			}
+			if rest[i] == '"' {
+				closed = true
+				break
+			}
+			value.WriteByte(rest[i])
+		}
+		if !closed {
+			return nil, false
+		}
+
+		strs = append(strs, value.String())
+		rest = strings.TrimLeft(rest[i+1:], " \t")
+	}
+
+	return strs, true
+}
+
+// domainsV1SplitTXTStrings splits the value into character strings of up to 255 bytes
+// without breaking multibyte characters.
+func domainsV1SplitTXTStrings(value string) []string {
+	var strs []string
+	for len(value) > domainsV1TXTStringMaxLength {
+		i := domainsV1TXTStringMaxLength
+		for i > 0 && !utf8.RuneStart(value[i]) {
+			i--
+		}
+		if i == 0 {
+			i = domainsV1TXTStringMaxLength
+		}
+		strs = append(strs, value[:i])
+		value = value[i:]
+	}
+
+	return append(strs, value)
+}
+
+// domainsV1SplitTXTContent returns the TXT content that is longer than 255 bytes
+// as a sequence of quoted character strings. Short and already quoted content
+// is returned as is.
+func domainsV1SplitTXTContent(content string) string {
+	if len(content) <= domainsV1TXTStringMaxLength {
+		return content
+	}
+	if _, ok := domainsV1ParseTXTStrings(content); ok {
+		return content
+	}
+
+	return domainsV1QuoteZoneString(content)
+}
+
+// domainsV1RecordContentDiffSuppressFunc suppresses the diff between a long TXT
+// content and the same content split into character strings.
+func domainsV1RecordContentDiffSuppressFunc(_, old, new string, d *schema.ResourceData) bool {
+	return d.Get("type").(string) == TypeRecordTXT && old == domainsV1SplitTXTContent(new)
+}
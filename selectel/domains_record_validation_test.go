@@ -0,0 +1,335 @@
+package selectel
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/selectel/domains-go/pkg/v1/record"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainsV1ValidateRecordOpts(t *testing.T) {
+	tableTest := []struct {
+		name string
+		opts *record.CreateOpts
+		err  string
+	}{
+		{
+			name: "valid A",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordA), Content: "127.0.0.1"},
+		},
+		{
+			name: "invalid IPv4 in A",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordA), Content: "127.0.0.256"},
+			err:  "invalid A record: content '127.0.0.256' is not a valid IPv4 address",
+		},
+		{
+			name: "IPv6 in A",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordA), Content: "2400:cb00:2049:1::a29f:1804"},
+			err:  "invalid A record: content '2400:cb00:2049:1::a29f:1804' is not a valid IPv4 address",
+		},
+		{
+			name: "IPv4-mapped IPv6 in A",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordA), Content: "::ffff:127.0.0.1"},
+			err:  "invalid A record: content '::ffff:127.0.0.1' is not a valid IPv4 address",
+		},
+		{
+			name: "valid AAAA",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordAAAA), Content: "2400:cb00:2049:1::a29f:1804"},
+		},
+		{
+			name: "IPv4 in AAAA",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordAAAA), Content: "127.0.0.1"},
+			err:  "invalid AAAA record: content '127.0.0.1' is not a valid IPv6 address",
+		},
+		{
+			name: "valid TXT",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordTXT), Content: "hello, world!"},
+		},
+		{
+			name: "long TXT",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordTXT), Content: strings.Repeat("a", 1000)},
+		},
+		{
+			name: "empty TXT",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordTXT)},
+			err:  "invalid TXT record: content is required",
+		},
+		{
+			name: "too long TXT string",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordTXT), Content: `"a" "` + strings.Repeat("b", 256) + `"`},
+			err:  "invalid TXT record: string 2 of the content is longer than 255 bytes",
+		},
+		{
+			name: "too long TXT",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordTXT), Content: strings.Repeat("a", 65535)},
+			err:  "invalid TXT record: content is longer than 65535 bytes",
+		},
+		{
+			name: "valid CNAME",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordCNAME), Content: "origin.com"},
+		},
+		{
+			name: "invalid CNAME",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordCNAME), Content: "origin..com"},
+			err:  "invalid CNAME record: content 'origin..com' is not a valid hostname",
+		},
+		{
+			name: "valid NS",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordNS), Content: "ns.example.org."},
+		},
+		{
+			name: "empty NS",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordNS)},
+			err:  "invalid NS record: content is required",
+		},
+		{
+			name: "valid SOA",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordSOA), Content: "ns1.selectel.org", Email: "support@selectel.ru"},
+		},
+		{
+			name: "SOA without email",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordSOA), Content: "ns1.selectel.org"},
+			err:  "invalid SOA record: email is required",
+		},
+		{
+			name: "valid MX",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordMX), Content: "mail.example.org", Priority: intPtr(10)},
+		},
+		{
+			name: "null MX",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordMX), Content: ".", Priority: intPtr(0)},
+		},
+		{
+			name: "invalid MX",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordMX), Content: "mail example", Priority: intPtr(10)},
+			err:  "invalid MX record: content 'mail example' is not a valid hostname",
+		},
+		{
+			name: "valid SRV",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordSRV), Target: "sip.example.com"},
+		},
+		{
+			name: "SRV without target",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordSRV)},
+			err:  "invalid SRV record: target is required",
+		},
+		{
+			name: "valid CAA",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordCAA), Tag: "issue", Value: "letsencrypt.org; validationmethods=dns-01"},
+		},
+		{
+			name: "CAA forbidding issuance",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordCAA), Tag: "issuewild", Value: ";"},
+		},
+		{
+			name: "malformed CAA tag",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordCAA), Tag: "is-sue", Value: "letsencrypt.org"},
+			err:  "invalid CAA record: tag 'is-sue' must consist of 1 to 15 letters and digits",
+		},
+		{
+			name: "invalid CAA issuer",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordCAA), Tag: "issue", Value: "lets encrypt"},
+			err:  "invalid CAA record: value 'lets encrypt' is not a valid hostname",
+		},
+		{
+			name: "valid CAA iodef",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordCAA), Tag: "iodef", Value: "mailto:security@example.org"},
+		},
+		{
+			name: "invalid CAA iodef",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordCAA), Tag: "iodef", Value: "security@example.org"},
+			err:  "invalid CAA record: value 'security@example.org' of the iodef tag must be a mailto, http or https URL",
+		},
+		{
+			name: "valid SSHFP",
+			opts: &record.CreateOpts{
+				Type:            record.Type(TypeRecordSSHFP),
+				FingerprintType: intPtr(1),
+				Fingerprint:     "123456789abcdef67890123456789abcdef67890",
+			},
+		},
+		{
+			name: "SSHFP with wrong fingerprint length",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordSSHFP), FingerprintType: intPtr(2), Fingerprint: "00BB"},
+			err:  "invalid SSHFP record: fingerprint of type 2 must be 64 hexadecimal characters long, got 4",
+		},
+		{
+			name: "valid ALIAS",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordALIAS), Content: "tf-acc.xyz"},
+		},
+		{
+			name: "ALIAS with URL",
+			opts: &record.CreateOpts{Type: record.Type(TypeRecordALIAS), Content: "https://tf-acc.xyz"},
+			err:  "invalid ALIAS record: content 'https://tf-acc.xyz' is not a valid hostname",
+		},
+	}
+
+	for _, test := range tableTest {
+		t.Run(test.name, func(t *testing.T) {
+			err := domainsV1ValidateRecordOpts(test.opts)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestDomainsV1ValidateRecordConflicts(t *testing.T) {
+	records := []*record.View{
+		{ID: 1, Name: "example.org", Type: record.Type(TypeRecordA)},
+		{ID: 2, Name: "www.example.org", Type: record.Type(TypeRecordA)},
+		{ID: 3, Name: "ftp.example.org", Type: record.Type(TypeRecordCNAME)},
+	}
+
+	tableTest := []struct {
+		name     string
+		recordID int
+		opts     *record.CreateOpts
+		err      string
+	}{
+		{
+			name: "CNAME with a new name",
+			opts: &record.CreateOpts{Name: "cdn.example.org", Type: record.Type(TypeRecordCNAME)},
+		},
+		{
+			name: "CNAME at the apex",
+			opts: &record.CreateOpts{Name: "example.org.", Type: record.Type(TypeRecordCNAME)},
+			err:  "invalid CNAME record: can't be created at the zone apex 'example.org'",
+		},
+		{
+			name: "CNAME next to an A record",
+			opts: &record.CreateOpts{Name: "www.example.org", Type: record.Type(TypeRecordCNAME)},
+			err: "invalid CNAME record: name 'www.example.org' already has a A record with ID 2 " +
+				"and CNAME records can't coexist with other records",
+		},
+		{
+			name: "A record next to a CNAME",
+			opts: &record.CreateOpts{Name: "FTP.example.org", Type: record.Type(TypeRecordA)},
+			err: "invalid A record: name 'FTP.example.org' already has a CNAME record with ID 3 " +
+				"and CNAME records can't coexist with other records",
+		},
+		{
+			name:     "A record changed to CNAME",
+			recordID: 2,
+			opts:     &record.CreateOpts{Name: "www.example.org", Type: record.Type(TypeRecordCNAME)},
+		},
+		{
+			name: "A record next to an A record",
+			opts: &record.CreateOpts{Name: "www.example.org", Type: record.Type(TypeRecordA)},
+		},
+	}
+
+	for _, test := range tableTest {
+		t.Run(test.name, func(t *testing.T) {
+			err := domainsV1ValidateRecordConflicts("example.org", test.recordID, test.opts, records)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestResourceDomainsRRSetV1CustomizeDiff(t *testing.T) {
+	tableTest := []struct {
+		name       string
+		recordType string
+		records    []interface{}
+		err        string
+	}{
+		{
+			name:       "valid A",
+			recordType: TypeRecordA,
+			records:    []interface{}{"127.0.0.1", "127.0.0.2"},
+		},
+		{
+			name:       "invalid IPv4 in A",
+			recordType: TypeRecordA,
+			records:    []interface{}{"127.0.0.1", "127.0.0.256"},
+			err:        "invalid A record: content '127.0.0.256' is not a valid IPv4 address",
+		},
+		{
+			name:       "SSHFP with wrong fingerprint length",
+			recordType: TypeRecordSSHFP,
+			records:    []interface{}{"1 1 00BB"},
+			err:        "invalid SSHFP record: fingerprint of type 1 must be 40 hexadecimal characters long, got 4",
+		},
+	}
+
+	for _, test := range tableTest {
+		t.Run(test.name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"domain_id": 1,
+				"name":      "www.example.org",
+				"type":      test.recordType,
+				"ttl":       60,
+				"records":   test.records,
+			}
+
+			_, err := resourceDomainsRRSetV1().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestDomainsV1ParseTXTStrings(t *testing.T) {
+	tableTest := []struct {
+		content  string
+		expected []string
+		ok       bool
+	}{
+		{
+			content: "hello, world!",
+		},
+		{
+			content:  `"v=spf1 include:_spf.example.org " "~all"`,
+			expected: []string{"v=spf1 include:_spf.example.org ", "~all"},
+			ok:       true,
+		},
+		{
+			content:  `"say \"hello\""`,
+			expected: []string{`say "hello"`},
+			ok:       true,
+		},
+		{
+			content: `"unterminated`,
+		},
+		{
+			content: `"quoted" and not`,
+		},
+	}
+
+	for _, test := range tableTest {
+		actual, ok := domainsV1ParseTXTStrings(test.content)
+		assert.Equal(t, test.ok, ok, test.content)
+		assert.Equal(t, test.expected, actual, test.content)
+	}
+}
+
+func TestDomainsV1SplitTXTStrings(t *testing.T) {
+	strs := domainsV1SplitTXTStrings(strings.Repeat("a", 600))
+	assert.Equal(t, []string{strings.Repeat("a", 255), strings.Repeat("a", 255), strings.Repeat("a", 90)}, strs)
+
+	// Multibyte characters must not be split between strings.
+	strs = domainsV1SplitTXTStrings("a" + strings.Repeat("я", 200))
+	assert.Equal(t, []string{"a" + strings.Repeat("я", 127), strings.Repeat("я", 73)}, strs)
+}
+
+func TestDomainsV1SplitTXTContent(t *testing.T) {
+	long := strings.Repeat("a", 300)
+	quoted := `"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `"`
+
+	assert.Equal(t, "hello, world!", domainsV1SplitTXTContent("hello, world!"))
+	assert.Equal(t, quoted, domainsV1SplitTXTContent(long))
+	assert.Equal(t, quoted, domainsV1SplitTXTContent(quoted))
+}
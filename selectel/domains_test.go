@@ -1,8 +1,14 @@
 package selectel
 
 import (
+	"context"
+	"encoding/binary"
+	"net"
+	"strings"
 	"testing"
 
+	"github.com/selectel/domains-go/pkg/v1/domain"
+	"github.com/selectel/domains-go/pkg/v1/record"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -71,3 +77,250 @@ func TestGetIntPtrOrNil(t *testing.T) {
 		getIntPtrOrNil(test.input)
 	}
 }
+
+func TestDomainsV1ParseRRSetID(t *testing.T) {
+	tableTest := []struct {
+		input            string
+		expectedDomainID int
+		expectedName     string
+		expectedType     string
+		err              error
+	}{
+		{
+			input:            "123/www.example.org/a",
+			expectedDomainID: 123,
+			expectedName:     "www.example.org",
+			expectedType:     "A",
+		},
+		{
+			input:            "123/www.example.org",
+			expectedDomainID: -1,
+			err:              errParseDomainsRRSetV1ID("123/www.example.org"),
+		},
+		{
+			input:            "invalid/www.example.org/A",
+			expectedDomainID: -1,
+			err:              errParseDomainsDomainV1ID("invalid"),
+		},
+	}
+
+	for _, test := range tableTest {
+		gotDomainID, gotName, gotType, err := domainsV1ParseRRSetID(test.input)
+		assert.Equal(t, test.err, err)
+		assert.Equal(t, test.expectedDomainID, gotDomainID)
+		assert.Equal(t, test.expectedName, gotName)
+		assert.Equal(t, test.expectedType, gotType)
+	}
+}
+
+func TestDomainsV1RecordNamesEqual(t *testing.T) {
+	assert.True(t, domainsV1RecordNamesEqual("www.example.org", "WWW.example.org."))
+	assert.False(t, domainsV1RecordNamesEqual("www.example.org", "example.org"))
+}
+
+func TestDomainsV1RecordOptsFromRData(t *testing.T) {
+	tableTest := []struct {
+		recordType string
+		rdata      string
+		expected   *record.CreateOpts
+		err        error
+	}{
+		{
+			recordType: TypeRecordA,
+			rdata:      "127.0.0.1",
+			expected:   &record.CreateOpts{Type: record.TypeA, Content: "127.0.0.1"},
+		},
+		{
+			recordType: TypeRecordTXT,
+			rdata:      "v=spf1 include:_spf.example.org ~all",
+			expected:   &record.CreateOpts{Type: record.TypeTXT, Content: "v=spf1 include:_spf.example.org ~all"},
+		},
+		{
+			recordType: TypeRecordMX,
+			rdata:      "10 mail.example.org",
+			expected:   &record.CreateOpts{Type: record.TypeMX, Priority: intPtr(10), Content: "mail.example.org"},
+		},
+		{
+			recordType: TypeRecordSRV,
+			rdata:      "0 10 5060 sip.example.org",
+			expected:   &record.CreateOpts{Type: record.TypeSRV, Priority: intPtr(0), Weight: intPtr(10), Port: intPtr(5060), Target: "sip.example.org"},
+		},
+		{
+			recordType: TypeRecordCAA,
+			rdata:      `128 issue "letsencrypt.org"`,
+			expected:   &record.CreateOpts{Type: record.TypeCAA, Flag: intPtr(128), Tag: "issue", Value: "letsencrypt.org"},
+		},
+		{
+			recordType: TypeRecordSSHFP,
+			rdata:      "1 1 abcdef",
+			expected:   &record.CreateOpts{Type: record.TypeSSHFP, Algorithm: intPtr(1), FingerprintType: intPtr(1), Fingerprint: "abcdef"},
+		},
+		{
+			recordType: TypeRecordMX,
+			rdata:      "mail.example.org",
+			err:        errParseDomainsRecordV1RData(TypeRecordMX, "mail.example.org"),
+		},
+		{
+			recordType: TypeRecordSRV,
+			rdata:      "0 ten 5060 sip.example.org",
+			err:        errParseDomainsRecordV1RData(TypeRecordSRV, "0 ten 5060 sip.example.org"),
+		},
+		{
+			recordType: TypeRecordSOA,
+			rdata:      "ns1.example.org",
+			err:        errParseDomainsRecordV1RData(TypeRecordSOA, "ns1.example.org"),
+		},
+	}
+
+	for _, test := range tableTest {
+		actual, err := domainsV1RecordOptsFromRData(test.recordType, test.rdata)
+		assert.Equal(t, test.err, err)
+		assert.Equal(t, test.expected, actual)
+		if err == nil {
+			assert.Equal(t, test.rdata, domainsV1RecordRData(domainsV1RecordViewFromOpts(actual)))
+		}
+	}
+}
+
+func TestDomainsV1ACMEChallengeName(t *testing.T) {
+	assert.Equal(t, "_acme-challenge.www.example.org", domainsV1ACMEChallengeName("www.example.org"))
+	assert.Equal(t, "_acme-challenge.example.org", domainsV1ACMEChallengeName("*.Example.org."))
+}
+
+func TestDomainsV1MatchDomain(t *testing.T) {
+	domains := []*domain.View{
+		{ID: 1, Name: "example.org"},
+		{ID: 2, Name: "dev.example.org"},
+		{ID: 3, Name: "ample.org"},
+	}
+
+	tableTest := []struct {
+		name       string
+		expectedID int
+	}{
+		{name: "_acme-challenge.example.org", expectedID: 1},
+		{name: "_acme-challenge.api.dev.example.org.", expectedID: 2},
+		{name: "DEV.example.org", expectedID: 2},
+		{name: "_acme-challenge.example.com"},
+	}
+
+	for _, test := range tableTest {
+		matched := domainsV1MatchDomain(domains, test.name)
+		if test.expectedID == 0 {
+			assert.Nil(t, matched, test.name)
+		} else if assert.NotNil(t, matched, test.name) {
+			assert.Equal(t, test.expectedID, matched.ID, test.name)
+		}
+	}
+}
+
+func TestDomainsV1NameserverAddress(t *testing.T) {
+	assert.Equal(t, "ns1.selectel.org:53", domainsV1NameserverAddress("ns1.selectel.org."))
+	assert.Equal(t, "127.0.0.1:5353", domainsV1NameserverAddress("127.0.0.1:5353"))
+	assert.Equal(t, "[2001:db8::1]:53", domainsV1NameserverAddress("2001:db8::1"))
+}
+
+func TestDomainsV1ACMEChallengeStateRefreshFunc(t *testing.T) {
+	name := "_acme-challenge.example.org"
+	propagated := testDomainsV1TXTServer(t, map[string][]string{
+		name: {"other", "digest"},
+	})
+	stale := testDomainsV1TXTServer(t, map[string][]string{
+		name: {"other"},
+	})
+	empty := testDomainsV1TXTServer(t, map[string][]string{})
+
+	tableTest := []struct {
+		nameservers   []string
+		expectedState string
+	}{
+		{
+			nameservers:   []string{propagated},
+			expectedState: domainsV1ACMEChallengeStatePropagated,
+		},
+		{
+			nameservers:   []string{propagated, stale},
+			expectedState: domainsV1ACMEChallengeStatePending,
+		},
+		{
+			nameservers:   []string{empty},
+			expectedState: domainsV1ACMEChallengeStatePending,
+		},
+	}
+
+	for _, test := range tableTest {
+		refresh := domainsV1ACMEChallengeStateRefreshFunc(context.Background(), test.nameservers, name, "digest")
+		_, state, err := refresh()
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedState, state, test.nameservers)
+	}
+}
+
+// testDomainsV1TXTServer starts a UDP DNS server that answers TXT queries
+// with the given records and returns its address.
+func testDomainsV1TXTServer(t *testing.T, records map[string][]string) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if resp := testDomainsV1TXTResponse(buf[:n], records); resp != nil {
+				_, _ = conn.WriteTo(resp, addr)
+			}
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func testDomainsV1TXTResponse(query []byte, records map[string][]string) []byte {
+	const headerLength = 12
+
+	// Read the name of the single question.
+	var labels []string
+	i := headerLength
+	for i < len(query) && query[i] != 0 {
+		length := int(query[i])
+		if i+1+length > len(query) {
+			return nil
+		}
+		labels = append(labels, string(query[i+1:i+1+length]))
+		i += 1 + length
+	}
+	questionEnd := i + 5
+	if questionEnd > len(query) {
+		return nil
+	}
+	values, ok := records[strings.ToLower(strings.Join(labels, "."))]
+
+	resp := make([]byte, 0, 512)
+	resp = append(resp, query[0], query[1])
+	if ok {
+		resp = append(resp, 0x84, 0x00)
+	} else {
+		// NXDOMAIN
+		resp = append(resp, 0x84, 0x03)
+	}
+	resp = append(resp, 0, 1)
+	resp = binary.BigEndian.AppendUint16(resp, uint16(len(values)))
+	resp = append(resp, 0, 0, 0, 0)
+	resp = append(resp, query[headerLength:questionEnd]...)
+
+	for _, value := range values {
+		// The name is a pointer to the question name, type TXT, class IN and TTL of 60 seconds.
+		resp = append(resp, 0xc0, headerLength, 0, 16, 0, 1, 0, 0, 0, 60)
+		resp = binary.BigEndian.AppendUint16(resp, uint16(len(value)+1))
+		resp = append(resp, byte(len(value)))
+		resp = append(resp, value...)
+	}
+
+	return resp
+}
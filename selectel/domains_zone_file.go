@@ -0,0 +1,499 @@
+package selectel
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/selectel/domains-go/pkg/v1/record"
+)
+
+// domainsV1ZoneFileRecordTypes contains record types that can be created from zone files.
+// Other records, e.g. SOA records that are managed by the service, are skipped.
+var domainsV1ZoneFileRecordTypes = map[string]bool{
+	TypeRecordA:     true,
+	TypeRecordAAAA:  true,
+	TypeRecordTXT:   true,
+	TypeRecordCNAME: true,
+	TypeRecordNS:    true,
+	TypeRecordMX:    true,
+	TypeRecordSRV:   true,
+	TypeRecordCAA:   true,
+	TypeRecordSSHFP: true,
+	TypeRecordALIAS: true,
+}
+
+type domainsV1ZoneRecord struct {
+	name       string
+	recordType string
+	ttl        int
+	rdata      string
+}
+
+type domainsV1ZoneFile struct {
+	records []domainsV1ZoneRecord
+	skipped []string
+}
+
+type domainsV1ZoneToken struct {
+	value  string
+	quoted bool
+}
+
+type domainsV1ZoneEntry struct {
+	line         int
+	inheritOwner bool
+	tokens       []domainsV1ZoneToken
+}
+
+// domainsV1ParseZoneFile parses records of an RFC 1035 zone file. Names are returned
+// fully qualified without the trailing dot and record data is returned in the form
+// of domainsV1RecordRData.
+func domainsV1ParseZoneFile(content, origin string, defaultTTL int) (*domainsV1ZoneFile, error) {
+	entries, err := domainsV1SplitZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := &domainsV1ZoneFile{}
+	seen := make(map[string]bool)
+	origin = strings.TrimSuffix(origin, ".")
+	ttl := defaultTTL
+	owner := ""
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if !tokens[0].quoted && strings.HasPrefix(tokens[0].value, "$") {
+			directive := strings.ToUpper(tokens[0].value)
+			if len(tokens) != 2 {
+				return nil, errParseDomainsZoneFileV1(entry.line, fmt.Errorf("%s expects a single value", directive))
+			}
+			switch directive {
+			case "$ORIGIN":
+				origin = strings.TrimSuffix(tokens[1].value, ".")
+			case "$TTL":
+				ttl, err = domainsV1ParseZoneTTL(tokens[1].value)
+				if err != nil {
+					return nil, errParseDomainsZoneFileV1(entry.line, err)
+				}
+			default:
+				return nil, errParseDomainsZoneFileV1(entry.line, fmt.Errorf("unsupported directive %s", directive))
+			}
+			continue
+		}
+
+		if !entry.inheritOwner {
+			owner, err = domainsV1ZoneName(tokens[0].value, origin)
+			if err != nil {
+				return nil, errParseDomainsZoneFileV1(entry.line, err)
+			}
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, errParseDomainsZoneFileV1(entry.line, errors.New("record has no owner name"))
+		}
+
+		// The TTL and the class can go in any order before the type.
+		recordTTL := ttl
+		for len(tokens) > 0 {
+			value := strings.ToUpper(tokens[0].value)
+			if value == "IN" {
+				tokens = tokens[1:]
+				continue
+			}
+			if value == "CH" || value == "CS" || value == "HS" {
+				return nil, errParseDomainsZoneFileV1(entry.line, fmt.Errorf("unsupported class %s", value))
+			}
+			if parsedTTL, err := domainsV1ParseZoneTTL(value); err == nil {
+				recordTTL = parsedTTL
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) == 0 {
+			return nil, errParseDomainsZoneFileV1(entry.line, errors.New("record has no type"))
+		}
+
+		recordType := strings.ToUpper(tokens[0].value)
+		if !domainsV1ZoneFileRecordTypes[recordType] {
+			zone.skipped = append(zone.skipped, fmt.Sprintf("%s %d %s", owner, recordTTL, recordType))
+			continue
+		}
+
+		rdata, err := domainsV1ZoneRData(recordType, tokens[1:], origin)
+		if err != nil {
+			return nil, errParseDomainsZoneFileV1(entry.line, err)
+		}
+
+		key := strings.ToLower(owner) + "/" + recordType + "/" + rdata
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		zone.records = append(zone.records, domainsV1ZoneRecord{
+			name:       owner,
+			recordType: recordType,
+			ttl:        recordTTL,
+			rdata:      rdata,
+		})
+	}
+
+	return zone, nil
+}
+
+// domainsV1SplitZoneFile splits the zone file into entries. Comments are removed and
+// entries that span several lines with parentheses are joined.
+func domainsV1SplitZoneFile(content string) ([]domainsV1ZoneEntry, error) {
+	var (
+		entries  []domainsV1ZoneEntry
+		current  domainsV1ZoneEntry
+		token    strings.Builder
+		inToken  bool
+		quoted   bool
+		inQuotes bool
+	)
+	line := 1
+	depth := 0
+	lineStart := true
+
+	flush := func() {
+		if !inToken {
+			return
+		}
+		if len(current.tokens) == 0 {
+			current.line = line
+		}
+		current.tokens = append(current.tokens, domainsV1ZoneToken{value: token.String(), quoted: quoted})
+		token.Reset()
+		inToken = false
+		quoted = false
+	}
+
+	runes := []rune(content)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+
+		if inQuotes {
+			switch c {
+			case '\\':
+				// Escapes are kept and resolved by domainsV1UnescapeZoneString.
+				token.WriteRune(c)
+				if i+1 < len(runes) {
+					i++
+					token.WriteRune(runes[i])
+				}
+			case '"':
+				inQuotes = false
+				flush()
+			case '\n':
+				return nil, errParseDomainsZoneFileV1(line, errors.New("unterminated quoted string"))
+			default:
+				token.WriteRune(c)
+			}
+			continue
+		}
+
+		switch {
+		case c == ';':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case c == '"':
+			flush()
+			inQuotes = true
+			inToken = true
+			quoted = true
+		case c == '(':
+			flush()
+			depth++
+		case c == ')':
+			flush()
+			depth--
+			if depth < 0 {
+				return nil, errParseDomainsZoneFileV1(line, errors.New("unbalanced parentheses"))
+			}
+		case c == '\n':
+			flush()
+			if depth == 0 {
+				if len(current.tokens) > 0 {
+					entries = append(entries, current)
+				}
+				current = domainsV1ZoneEntry{}
+			}
+			line++
+			lineStart = true
+			continue
+		case unicode.IsSpace(c):
+			if lineStart && depth == 0 && len(current.tokens) == 0 {
+				current.inheritOwner = true
+			}
+			flush()
+		default:
+			token.WriteRune(c)
+			inToken = true
+		}
+		lineStart = false
+	}
+
+	if inQuotes {
+		return nil, errParseDomainsZoneFileV1(line, errors.New("unterminated quoted string"))
+	}
+	if depth != 0 {
+		return nil, errParseDomainsZoneFileV1(line, errors.New("unbalanced parentheses"))
+	}
+	flush()
+	if len(current.tokens) > 0 {
+		entries = append(entries, current)
+	}
+
+	return entries, nil
+}
+
+// domainsV1ZoneRData converts record data of the zone file to the form of domainsV1RecordRData.
+func domainsV1ZoneRData(recordType string, tokens []domainsV1ZoneToken, origin string) (string, error) {
+	values := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		values = append(values, t.value)
+	}
+
+	var (
+		rdata string
+		err   error
+	)
+	switch recordType {
+	case TypeRecordCNAME, TypeRecordNS, TypeRecordALIAS:
+		if len(values) != 1 {
+			return "", errParseDomainsRecordV1RData(recordType, strings.Join(values, " "))
+		}
+		rdata, err = domainsV1ZoneName(values[0], origin)
+	case TypeRecordMX:
+		if len(values) != 2 {
+			return "", errParseDomainsRecordV1RData(recordType, strings.Join(values, " "))
+		}
+		values[1], err = domainsV1ZoneName(values[1], origin)
+		rdata = strings.Join(values, " ")
+	case TypeRecordSRV:
+		if len(values) != 4 {
+			return "", errParseDomainsRecordV1RData(recordType, strings.Join(values, " "))
+		}
+		values[3], err = domainsV1ZoneName(values[3], origin)
+		rdata = strings.Join(values, " ")
+	case TypeRecordTXT:
+		var content strings.Builder
+		for _, t := range tokens {
+			if t.quoted {
+				content.WriteString(domainsV1UnescapeZoneString(t.value))
+			} else {
+				content.WriteString(t.value)
+			}
+		}
+		rdata = content.String()
+	case TypeRecordCAA:
+		if len(tokens) != 3 {
+			return "", errParseDomainsRecordV1RData(recordType, strings.Join(values, " "))
+		}
+		rdata = fmt.Sprintf(`%s %s "%s"`, values[0], values[1], domainsV1UnescapeZoneString(values[2]))
+	case TypeRecordSSHFP:
+		// Long fingerprints can be split into several parts.
+		if len(values) < 3 {
+			return "", errParseDomainsRecordV1RData(recordType, strings.Join(values, " "))
+		}
+		rdata = fmt.Sprintf("%s %s %s", values[0], values[1], strings.Join(values[2:], ""))
+	default:
+		rdata = strings.Join(values, " ")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Parse the record data to validate and normalize it.
+	opts, err := domainsV1RecordOptsFromRData(recordType, rdata)
+	if err != nil {
+		return "", err
+	}
+
+	return domainsV1RecordRData(domainsV1RecordViewFromOpts(opts)), nil
+}
+
+// domainsV1ZoneName returns the fully qualified name without the trailing dot.
+func domainsV1ZoneName(name, origin string) (string, error) {
+	switch {
+	case name == "@":
+		if origin == "" {
+			return "", errors.New("@ is used without origin")
+		}
+		return origin, nil
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, "."), nil
+	case origin == "":
+		return "", fmt.Errorf("relative name %s is used without origin", name)
+	default:
+		return name + "." + origin, nil
+	}
+}
+
+// domainsV1ParseZoneTTL parses TTLs in seconds or with the s, m, h, d and w units, e.g. 1h30m.
+func domainsV1ParseZoneTTL(value string) (int, error) {
+	if value == "" || !unicode.IsDigit(rune(value[0])) {
+		return 0, fmt.Errorf("invalid TTL %s", value)
+	}
+	if ttl, err := strconv.Atoi(value); err == nil {
+		return ttl, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	ttl, number := 0, 0
+	hasNumber := false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number = number*10 + int(c-'0')
+			hasNumber = true
+			continue
+		}
+		unit, ok := units[byte(unicode.ToLower(rune(c)))]
+		if !ok || !hasNumber {
+			return 0, fmt.Errorf("invalid TTL %s", value)
+		}
+		ttl += number * unit
+		number = 0
+		hasNumber = false
+	}
+	if hasNumber {
+		return 0, fmt.Errorf("invalid TTL %s", value)
+	}
+
+	return ttl, nil
+}
+
+// domainsV1UnescapeZoneString resolves \X and \DDD escapes of zone file strings.
+func domainsV1UnescapeZoneString(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+
+	var result strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i+1 == len(value) {
+			result.WriteByte(value[i])
+			continue
+		}
+		if i+3 < len(value) && isDigits(value[i+1:i+4]) {
+			code, _ := strconv.Atoi(value[i+1 : i+4])
+			result.WriteByte(byte(code))
+			i += 3
+			continue
+		}
+		i++
+		result.WriteByte(value[i])
+	}
+
+	return result.String()
+}
+
+func isDigits(value string) bool {
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// domainsV1SortZoneRecords returns records in the zone file order. The SOA record goes
+// first, the NS records of the apex go next and other records are sorted by the name,
+// the type and the data.
+func domainsV1SortZoneRecords(domainName string, records []*record.View) []*record.View {
+	sorted := make([]*record.View, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if rankA, rankB := domainsV1ZoneRecordRank(domainName, a), domainsV1ZoneRecordRank(domainName, b); rankA != rankB {
+			return rankA < rankB
+		}
+		if nameA, nameB := strings.ToLower(a.Name), strings.ToLower(b.Name); nameA != nameB {
+			return nameA < nameB
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+
+		return domainsV1RecordRData(a) < domainsV1RecordRData(b)
+	})
+
+	return sorted
+}
+
+// domainsV1RenderZoneFile renders records of the domain as an RFC 1035 zone file
+// with fully qualified names.
+func domainsV1RenderZoneFile(domainName string, records []*record.View) string {
+	var zone strings.Builder
+	fmt.Fprintf(&zone, "$ORIGIN %s\n", domainsV1ZoneFQDN(domainName))
+	for _, r := range domainsV1SortZoneRecords(domainName, records) {
+		if string(r.Type) == TypeRecordSOA {
+			// The v1 API doesn't return SOA timers, so the SOA record is left as a comment
+			// with the fields that are returned instead of a record with made-up timers.
+			zone.WriteString("; SOA timers aren't returned by the API\n; ")
+		}
+		fmt.Fprintf(&zone, "%s\t%d\tIN\t%s\t%s\n", domainsV1ZoneFQDN(r.Name), r.TTL, r.Type, domainsV1ZoneFileRData(r))
+	}
+
+	return zone.String()
+}
+
+func domainsV1ZoneRecordRank(domainName string, r *record.View) int {
+	switch {
+	case string(r.Type) == TypeRecordSOA:
+		return 0
+	case string(r.Type) == TypeRecordNS && domainsV1RecordNamesEqual(r.Name, domainName):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// domainsV1ZoneFileRData returns the record data in the zone file format.
+// Unlike domainsV1RecordRData, names are fully qualified and TXT data is quoted.
+func domainsV1ZoneFileRData(r *record.View) string {
+	switch string(r.Type) {
+	case TypeRecordCNAME, TypeRecordNS, TypeRecordALIAS:
+		return domainsV1ZoneFQDN(r.Content)
+	case TypeRecordMX:
+		return fmt.Sprintf("%d %s", intValue(r.Priority), domainsV1ZoneFQDN(r.Content))
+	case TypeRecordSRV:
+		return fmt.Sprintf("%d %d %d %s", intValue(r.Priority), intValue(r.Weight), intValue(r.Port), domainsV1ZoneFQDN(r.Target))
+	case TypeRecordTXT:
+		return domainsV1QuoteZoneString(r.Content)
+	case TypeRecordSOA:
+		return fmt.Sprintf("%s %s %d",
+			domainsV1ZoneFQDN(r.Content), domainsV1ZoneFQDN(strings.Replace(r.Email, "@", ".", 1)), intValue(r.ChangeDate))
+	default:
+		return domainsV1RecordRData(r)
+	}
+}
+
+func domainsV1ZoneFQDN(name string) string {
+	if name == "" || strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
+
+// domainsV1QuoteZoneString quotes the value and splits it into strings
+// of 255 bytes that is the limit of a single TXT string.
+func domainsV1QuoteZoneString(value string) string {
+	chunks := domainsV1SplitTXTStrings(value)
+	for i, chunk := range chunks {
+		chunk = strings.ReplaceAll(chunk, `\`, `\\`)
+		chunks[i] = `"` + strings.ReplaceAll(chunk, `"`, `\"`) + `"`
+	}
+
+	return strings.Join(chunks, " ")
+}
@@ -0,0 +1,144 @@
+package selectel
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/selectel/domains-go/pkg/v1/record"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainsV1ParseZoneFile(t *testing.T) {
+	content := `$ORIGIN example.org.
+$TTL 1h
+@       IN SOA ns1.selectel.org. support.selectel.ru. (
+            2023010101 ; serial
+            10800      ; refresh
+            3600       ; retry
+            604800     ; expire
+            60 )       ; minimum
+        IN NS   ns1.selectel.org.
+        IN NS   ns2.selectel.org.
+        IN MX   10 mail
+        IN TXT  "v=spf1 include:_spf.example.org " "~all"
+www 300 IN A    127.0.0.1
+www     IN A    127.0.0.1 ; duplicate
+www     IN A    127.0.0.2
+ftp     CNAME   www
+_sip._udp 1d SRV 0 10 5060 sip.example.com.
+@       CAA     0 issue "letsencrypt.org"
+host    SSHFP   1 1 ( abcdef
+                      012345 )
+note    TXT     "say \"hello\""
+ptr     PTR     host
+`
+
+	zone, err := domainsV1ParseZoneFile(content, "", 3600)
+	assert.NoError(t, err)
+
+	expected := []domainsV1ZoneRecord{
+		{name: "example.org", recordType: TypeRecordNS, ttl: 3600, rdata: "ns1.selectel.org"},
+		{name: "example.org", recordType: TypeRecordNS, ttl: 3600, rdata: "ns2.selectel.org"},
+		{name: "example.org", recordType: TypeRecordMX, ttl: 3600, rdata: "10 mail.example.org"},
+		{name: "example.org", recordType: TypeRecordTXT, ttl: 3600, rdata: "v=spf1 include:_spf.example.org ~all"},
+		{name: "www.example.org", recordType: TypeRecordA, ttl: 300, rdata: "127.0.0.1"},
+		{name: "www.example.org", recordType: TypeRecordA, ttl: 3600, rdata: "127.0.0.2"},
+		{name: "ftp.example.org", recordType: TypeRecordCNAME, ttl: 3600, rdata: "www.example.org"},
+		{name: "_sip._udp.example.org", recordType: TypeRecordSRV, ttl: 86400, rdata: "0 10 5060 sip.example.com"},
+		{name: "example.org", recordType: TypeRecordCAA, ttl: 3600, rdata: `0 issue "letsencrypt.org"`},
+		{name: "host.example.org", recordType: TypeRecordSSHFP, ttl: 3600, rdata: "1 1 abcdef012345"},
+		{name: "note.example.org", recordType: TypeRecordTXT, ttl: 3600, rdata: `say "hello"`},
+	}
+	assert.Equal(t, expected, zone.records)
+	assert.Equal(t, []string{"example.org 3600 SOA", "ptr.example.org 3600 PTR"}, zone.skipped)
+}
+
+func TestDomainsV1ParseZoneFileErrors(t *testing.T) {
+	tableTest := []struct {
+		content  string
+		origin   string
+		expected error
+	}{
+		{
+			content:  "www IN A 127.0.0.1",
+			expected: errParseDomainsZoneFileV1(1, errors.New("relative name www is used without origin")),
+		},
+		{
+			content:  "www IN A 127.0.0.1\nmail IN MX mail",
+			origin:   "example.org",
+			expected: errParseDomainsZoneFileV1(2, errParseDomainsRecordV1RData(TypeRecordMX, "mail")),
+		},
+		{
+			content:  "www IN A ( 127.0.0.1",
+			origin:   "example.org",
+			expected: errParseDomainsZoneFileV1(1, errors.New("unbalanced parentheses")),
+		},
+		{
+			content:  "$INCLUDE other.zone",
+			expected: errParseDomainsZoneFileV1(1, errors.New("unsupported directive $INCLUDE")),
+		},
+	}
+
+	for _, test := range tableTest {
+		_, err := domainsV1ParseZoneFile(test.content, test.origin, 3600)
+		assert.Equal(t, test.expected, err)
+	}
+}
+
+func TestDomainsV1ParseZoneTTL(t *testing.T) {
+	tableTest := []struct {
+		input    string
+		expected int
+		err      bool
+	}{
+		{input: "300", expected: 300},
+		{input: "1h30m", expected: 5400},
+		{input: "1W", expected: 604800},
+		{input: "1x", err: true},
+		{input: "h", err: true},
+	}
+
+	for _, test := range tableTest {
+		actual, err := domainsV1ParseZoneTTL(test.input)
+		assert.Equal(t, test.err, err != nil)
+		assert.Equal(t, test.expected, actual)
+	}
+}
+
+func TestDomainsV1RenderZoneFile(t *testing.T) {
+	records := []*record.View{
+		{ID: 5, Name: "www.example.org", Type: record.TypeA, TTL: 60, Content: "127.0.0.1"},
+		{ID: 4, Name: "example.org", Type: record.TypeMX, TTL: 3600, Priority: intPtr(10), Content: "mail.example.org"},
+		{ID: 3, Name: "example.org", Type: record.TypeTXT, TTL: 3600, Content: `say "hello"`},
+		{ID: 2, Name: "example.org", Type: record.TypeNS, TTL: 86400, Content: "ns2.selectel.org"},
+		{ID: 1, Name: "example.org", Type: record.TypeSOA, TTL: 3600, Content: "ns1.selectel.org", Email: "support@selectel.ru", ChangeDate: intPtr(1672531200)},
+		{ID: 6, Name: "_sip._udp.example.org", Type: record.TypeSRV, TTL: 60, Priority: intPtr(0), Weight: intPtr(10), Port: intPtr(5060), Target: "sip.example.org"},
+	}
+
+	expected := `$ORIGIN example.org.
+; SOA timers aren't returned by the API
+; example.org.	3600	IN	SOA	ns1.selectel.org. support.selectel.ru. 1672531200
+example.org.	86400	IN	NS	ns2.selectel.org.
+_sip._udp.example.org.	60	IN	SRV	0 10 5060 sip.example.org.
+example.org.	3600	IN	MX	10 mail.example.org.
+example.org.	3600	IN	TXT	"say \"hello\""
+www.example.org.	60	IN	A	127.0.0.1
+`
+
+	actual := domainsV1RenderZoneFile("example.org", records)
+	assert.Equal(t, expected, actual)
+
+	// The rendered zone file is parsed back to the same records.
+	zone, err := domainsV1ParseZoneFile(actual, "", 3600)
+	assert.NoError(t, err)
+	assert.Len(t, zone.records, len(records)-1)
+	assert.Equal(t, `say "hello"`, zone.records[3].rdata)
+}
+
+func TestDomainsV1QuoteZoneString(t *testing.T) {
+	long := strings.Repeat("a", 300)
+
+	assert.Equal(t, `"a\\b"`, domainsV1QuoteZoneString(`a\b`))
+	assert.Equal(t, `"`+long[:255]+`" "`+long[255:]+`"`, domainsV1QuoteZoneString(long))
+}
@@ -44,6 +44,22 @@ func errParseDomainsDomainRecordV1IDsPair(id string) error {
 	return fmt.Errorf("got error parsing domain/record IDs pair: %s", id)
 }
 
+func errParseDomainsRRSetV1ID(id string) error {
+	return fmt.Errorf("got error parsing domain/name/type rrset ID: %s", id)
+}
+
+func errParseDomainsRecordV1RData(recordType, rdata string) error {
+	return fmt.Errorf("got error parsing %s record data: %s", recordType, rdata)
+}
+
+func errValidateDomainsRecordV1(recordType string, err error) error {
+	return fmt.Errorf("invalid %s record: %s", recordType, err)
+}
+
+func errParseDomainsZoneFileV1(line int, err error) error {
+	return fmt.Errorf("got error parsing zone file at line %d: %s", line, err)
+}
+
 func errSearchingProjectRole(projectID string, err error) error {
 	return fmt.Errorf("can't find role for project '%s': %s", projectID, err)
 }
@@ -103,3 +119,19 @@ func errParseDatastoreV1Resize(err error) error {
 func errParseDatastoreV1Restore(err error) error {
 	return fmt.Errorf("got error parsing restore opts: %s", err)
 }
+
+func errDBaaSPasswordRequired(passwordKey string) error {
+	return fmt.Errorf("either '%s' or 'generate_password' must be set", passwordKey)
+}
+
+func errDBaaSPasswordConflict(passwordKey string) error {
+	return fmt.Errorf("'%s' can't be set together with 'generate_password = true'", passwordKey)
+}
+
+func errUnknownDatastoreConfigParameter(name, typeID string) error {
+	return fmt.Errorf("unknown configuration parameter '%s' for the datastore type '%s'", name, typeID)
+}
+
+func errInvalidDatastoreConfigParameter(name string, err error) error {
+	return fmt.Errorf("invalid value of configuration parameter '%s': %s", name, err)
+}
@@ -183,6 +183,44 @@ func TestErrParseDomainsDomainRecordV1IDsPair(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestErrParseDomainsRRSetV1ID(t *testing.T) {
+	id := "badid/www.example.org"
+
+	expected := fmt.Errorf("got error parsing domain/name/type rrset ID: %s", id)
+
+	actual := errParseDomainsRRSetV1ID(id)
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestErrParseDomainsRecordV1RData(t *testing.T) {
+	expected := errors.New("got error parsing MX record data: mail.example.org")
+
+	actual := errParseDomainsRecordV1RData("MX", "mail.example.org")
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestErrValidateDomainsRecordV1(t *testing.T) {
+	err := errors.New("content '127.0.0.256' is not a valid IPv4 address")
+
+	expected := errors.New("invalid A record: content '127.0.0.256' is not a valid IPv4 address")
+
+	actual := errValidateDomainsRecordV1("A", err)
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestErrParseDomainsZoneFileV1(t *testing.T) {
+	err := errors.New("unbalanced parentheses")
+
+	expected := errors.New("got error parsing zone file at line 3: unbalanced parentheses")
+
+	actual := errParseDomainsZoneFileV1(3, err)
+
+	assert.Equal(t, expected, actual)
+}
+
 func TestErrGettingObjects(t *testing.T) {
 	object := "datastore-types"
 	err := errors.New(testErrString)
@@ -243,3 +281,37 @@ func TestErrParseDatastoreV1Restore(t *testing.T) {
 
 	assert.Equal(t, expected, actual)
 }
+
+func TestErrDBaaSPasswordRequired(t *testing.T) {
+	expected := errors.New("either 'password' or 'generate_password' must be set")
+
+	actual := errDBaaSPasswordRequired("password")
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestErrDBaaSPasswordConflict(t *testing.T) {
+	expected := errors.New("'redis_password' can't be set together with 'generate_password = true'")
+
+	actual := errDBaaSPasswordConflict("redis_password")
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestErrUnknownDatastoreConfigParameter(t *testing.T) {
+	expected := errors.New("unknown configuration parameter 'work_mme' for the datastore type 'a1b2c3'")
+
+	actual := errUnknownDatastoreConfigParameter("work_mme", "a1b2c3")
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestErrInvalidDatastoreConfigParameter(t *testing.T) {
+	err := errors.New(testErrString)
+
+	expected := errors.New("invalid value of configuration parameter 'work_mem': got 503")
+
+	actual := errInvalidDatastoreConfigParameter("work_mem", err)
+
+	assert.Equal(t, expected, actual)
+}
@@ -46,3 +46,26 @@ resource "selectel_domains_record_v1" "record_a_tf_acc_test_1" {
 }
 `, domainName, recordName)
 }
+
+func TestAccDomainsRecordV1ImportByName(t *testing.T) {
+	resourceName := "selectel_domains_record_v1.record_a_tf_acc_test_1"
+	testDomainName := fmt.Sprintf("%s.xyz", acctest.RandomWithPrefix("tf-acc"))
+	testRecordName := fmt.Sprintf("a.%s", testDomainName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV1DomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsRecordV1BasicSingle(testDomainName, testRecordName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s/A", testDomainName, testRecordName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
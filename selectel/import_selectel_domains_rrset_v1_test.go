@@ -0,0 +1,31 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainsRRSetV1ImportBasic(t *testing.T) {
+	resourceName := "selectel_domains_rrset_v1.rrset_tf_acc_test_1"
+	testDomainName := fmt.Sprintf("%s.xyz", acctest.RandomWithPrefix("tf-acc"))
+	testRRSetName := fmt.Sprintf("www.%s", testDomainName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV1DomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsRRSetV1Basic(testDomainName, testRRSetName, 60, `"127.0.0.1", "127.0.0.2"`),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
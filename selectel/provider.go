@@ -24,6 +24,8 @@ const (
 	objectNodegroup               = "nodegroup"
 	objectDomain                  = "domain"
 	objectRecord                  = "record"
+	objectRRSet                   = "rrset"
+	objectACMEChallenge           = "ACME challenge"
 	objectDatastore               = "datastore"
 	objectDatabase                = "database"
 	objectGrant                   = "grant"
@@ -71,11 +73,15 @@ func Provider() *schema.Provider {
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"selectel_domains_domain_v1":                dataSourceDomainsDomainV1(),
+			"selectel_domains_zone_file_v1":             dataSourceDomainsZoneFileV1(),
+			"selectel_domains_zone_export_v1":           dataSourceDomainsZoneExportV1(),
+			"selectel_domains_records_v1":               dataSourceDomainsRecordsV1(),
 			"selectel_dbaas_datastore_type_v1":          dataSourceDBaaSDatastoreTypeV1(),
 			"selectel_dbaas_available_extension_v1":     dataSourceDBaaSAvailableExtensionV1(),
 			"selectel_dbaas_flavor_v1":                  dataSourceDBaaSFlavorV1(),
 			"selectel_dbaas_configuration_parameter_v1": dataSourceDBaaSConfigurationParameterV1(),
 			"selectel_dbaas_prometheus_metric_token_v1": dataSourceDBaaSPrometheusMetricTokenV1(),
+			"selectel_dbaas_connection_uri_v1":          dataSourceDBaaSConnectionURIV1(),
 			"selectel_mks_kubeconfig_v1":                dataSourceMKSKubeconfigV1(),
 			"selectel_mks_kube_versions_v1":             dataSourceMKSKubeVersionsV1(),
 			"selectel_mks_feature_gates_v1":             dataSourceMKSFeatureGatesV1(),
@@ -96,10 +102,13 @@ func Provider() *schema.Provider {
 			"selectel_mks_nodegroup_v1":                 resourceMKSNodegroupV1(),
 			"selectel_domains_domain_v1":                resourceDomainsDomainV1(),
 			"selectel_domains_record_v1":                resourceDomainsRecordV1(),
+			"selectel_domains_rrset_v1":                 resourceDomainsRRSetV1(),
+			"selectel_domains_acme_challenge_v1":        resourceDomainsACMEChallengeV1(),
 			"selectel_dbaas_datastore_v1":               resourceDBaaSDatastoreV1(), // DEPRECATED
 			"selectel_dbaas_postgresql_datastore_v1":    resourceDBaaSPostgreSQLDatastoreV1(),
 			"selectel_dbaas_mysql_datastore_v1":         resourceDBaaSMySQLDatastoreV1(),
 			"selectel_dbaas_redis_datastore_v1":         resourceDBaaSRedisDatastoreV1(),
+			"selectel_dbaas_kafka_datastore_v1":         resourceDBaaSKafkaDatastoreV1(),
 			"selectel_dbaas_user_v1":                    resourceDBaaSUserV1(),
 			"selectel_dbaas_database_v1":                resourceDBaaSDatabaseV1(), // DEPRECATED
 			"selectel_dbaas_postgresql_database_v1":     resourceDBaaSPostgreSQLDatabaseV1(),
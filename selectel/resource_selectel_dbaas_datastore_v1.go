@@ -179,10 +179,11 @@ func resourceDBaaSDatastoreV1() *schema.Resource {
 				},
 			},
 			"config": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Computed: true,
-				ForceNew: false,
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         false,
+				DiffSuppressFunc: dbaasDatastoreV1ConfigDiffSuppressFunc,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -228,7 +229,6 @@ func resourceDBaaSDatastoreV1Create(ctx context.Context, d *schema.ResourceData,
 		NodeCount: d.Get("node_count").(int),
 		Pooler:    pooler,
 		Restore:   restore,
-		Config:    d.Get("config").(map[string]interface{}),
 	}
 
 	if flavorOk {
@@ -250,6 +250,12 @@ func resourceDBaaSDatastoreV1Create(ctx context.Context, d *schema.ResourceData,
 		datastoreCreateOpts.RedisPassword = redisPassword.(string)
 	}
 
+	config, err := convertDatastoreConfigByType(ctx, dbaasClient, datastoreCreateOpts.TypeID, d.Get("config").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(errCreatingObject(objectDatastore, err))
+	}
+	datastoreCreateOpts.Config = config
+
 	log.Print(msgCreate(objectDatastore, datastoreCreateOpts))
 	datastore, err := dbaasClient.CreateDatastore(ctx, datastoreCreateOpts)
 	if err != nil {
@@ -297,10 +303,7 @@ func resourceDBaaSDatastoreV1Read(ctx context.Context, d *schema.ResourceData, m
 		log.Print(errSettingComplexAttr("connections", err))
 	}
 
-	configMap := make(map[string]string)
-	for key, value := range datastore.Config {
-		configMap[key] = convertFieldToStringByType(value)
-	}
+	configMap := flattenDatastoreConfig(d, datastore.Config)
 	if err := d.Set("config", configMap); err != nil {
 		log.Print(errSettingComplexAttr("config", err))
 	}
@@ -345,7 +348,7 @@ func resourceDBaaSDatastoreV1Update(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	if d.HasChange("redis_password") {
-		err := updateRedisDatastorePassword(ctx, d, dbaasClient)
+		err := updateRedisDatastorePassword(ctx, d, dbaasClient, d.Get("redis_password").(string))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -63,6 +63,14 @@ func resourceDBaaSMySQLDatabaseV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"connection_uri": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -115,6 +123,7 @@ func resourceDBaaSMySQLDatabaseV1Read(ctx context.Context, d *schema.ResourceDat
 	d.Set("datastore_id", database.DatastoreID)
 	d.Set("name", database.Name)
 	d.Set("status", database.Status)
+	setDBaaSDatabaseConnectionURI(ctx, d, dbaasClient, "mysql", database)
 
 	return nil
 }
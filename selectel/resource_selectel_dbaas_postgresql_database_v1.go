@@ -81,6 +81,14 @@ func resourceDBaaSPostgreSQLDatabaseV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"connection_uri": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -145,6 +153,7 @@ func resourceDBaaSPostgreSQLDatabaseV1Read(ctx context.Context, d *schema.Resour
 	if database.LcCtype != "" {
 		d.Set("lc_ctype", database.LcCtype)
 	}
+	setDBaaSDatabaseConnectionURI(ctx, d, dbaasClient, "postgresql", database)
 
 	return nil
 }
@@ -38,6 +38,7 @@ func TestAccDBaaSPostgreSQLDatabaseV1Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("selectel_dbaas_postgresql_database_v1.database_tf_acc_test_1", "lc_collate", "C"),
 					resource.TestCheckResourceAttr("selectel_dbaas_postgresql_database_v1.database_tf_acc_test_1", "lc_ctype", "C"),
 					resource.TestCheckResourceAttr("selectel_dbaas_postgresql_database_v1.database_tf_acc_test_1", "status", string(dbaas.StatusActive)),
+					resource.TestCheckResourceAttrSet("selectel_dbaas_postgresql_database_v1.database_tf_acc_test_1", "connection_uri.master"),
 				),
 			},
 			{
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,6 +26,10 @@ func resourceDBaaSPostgreSQLDatastoreV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDBaaSPostgreSQLDatastoreV1ImportState,
 		},
+		CustomizeDiff: customdiff.All(
+			dbaasDatastoreV1ConfigManagedKeysCustomizeDiff,
+			dbaasDatastoreV1ConfigCustomizeDiff("postgresql"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -61,9 +66,29 @@ func resourceDBaaSPostgreSQLDatastoreV1() *schema.Resource {
 				ForceNew: true,
 			},
 			"type_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"engine", "engine_version"},
+				AtLeastOneOf:  []string{"type_id", "engine_version"},
+			},
+			"engine": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"type_id"},
+				ValidateFunc:  validation.StringInSlice([]string{"postgresql"}, false),
+			},
+			"engine_version": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"type_id"},
+				AtLeastOneOf:     []string{"type_id", "engine_version"},
+				DiffSuppressFunc: dbaasDatastoreV1EngineVersionDiffSuppressFunc,
 			},
 			"flavor_id": {
 				Type:          schema.TypeString,
@@ -179,14 +204,20 @@ func resourceDBaaSPostgreSQLDatastoreV1() *schema.Resource {
 				},
 			},
 			"config": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Computed: true,
-				ForceNew: false,
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         false,
+				DiffSuppressFunc: dbaasDatastoreV1ConfigDiffSuppressFunc,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"config_managed_keys_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -204,7 +235,10 @@ func resourceDBaaSPostgreSQLDatastoreV1Create(ctx context.Context, d *schema.Res
 		return diag.FromErr(errors.New("either 'flavor' or 'flavor_id' must be provided"))
 	}
 
-	typeID := d.Get("type_id").(string)
+	typeID, err := getDatastoreTypeID(ctx, d, dbaasClient, "postgresql")
+	if err != nil {
+		return diag.FromErr(errCreatingObject(objectDatastore, err))
+	}
 	diagErr = validateDatastoreType(ctx, "postgresql", typeID, dbaasClient)
 	if diagErr != nil {
 		return diagErr
@@ -229,7 +263,6 @@ func resourceDBaaSPostgreSQLDatastoreV1Create(ctx context.Context, d *schema.Res
 		NodeCount: d.Get("node_count").(int),
 		Pooler:    pooler,
 		Restore:   restore,
-		Config:    d.Get("config").(map[string]interface{}),
 	}
 
 	if flavorOk {
@@ -246,6 +279,12 @@ func resourceDBaaSPostgreSQLDatastoreV1Create(ctx context.Context, d *schema.Res
 		datastoreCreateOpts.FlavorID = flavorID.(string)
 	}
 
+	config, err := convertDatastoreConfigByType(ctx, dbaasClient, typeID, d.Get("config").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(errCreatingObject(objectDatastore, err))
+	}
+	datastoreCreateOpts.Config = config
+
 	log.Print(msgCreate(objectDatastore, datastoreCreateOpts))
 	datastore, err := dbaasClient.CreateDatastore(ctx, datastoreCreateOpts)
 	if err != nil {
@@ -280,6 +319,7 @@ func resourceDBaaSPostgreSQLDatastoreV1Read(ctx context.Context, d *schema.Resou
 	d.Set("project_id", datastore.ProjectID)
 	d.Set("subnet_id", datastore.SubnetID)
 	d.Set("type_id", datastore.TypeID)
+	setDatastoreTypeEngine(ctx, d, dbaasClient, datastore.TypeID)
 	d.Set("node_count", datastore.NodeCount)
 	d.Set("enabled", datastore.Enabled)
 	d.Set("flavor_id", datastore.FlavorID)
@@ -293,10 +333,7 @@ func resourceDBaaSPostgreSQLDatastoreV1Read(ctx context.Context, d *schema.Resou
 		log.Print(errSettingComplexAttr("connections", err))
 	}
 
-	configMap := make(map[string]string)
-	for key, value := range datastore.Config {
-		configMap[key] = convertFieldToStringByType(value)
-	}
+	configMap := flattenDatastoreConfig(d, datastore.Config)
 	if err := d.Set("config", configMap); err != nil {
 		log.Print(errSettingComplexAttr("config", err))
 	}
@@ -334,14 +371,16 @@ func resourceDBaaSPostgreSQLDatastoreV1Update(ctx context.Context, d *schema.Res
 			return diag.FromErr(err)
 		}
 	}
+	var diags diag.Diagnostics
 	if d.HasChange("config") {
 		err := updateDatastoreConfig(ctx, d, dbaasClient)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		diags = dbaasDatastoreV1ConfigRestartWarning(ctx, d, dbaasClient)
 	}
 
-	return resourceDBaaSPostgreSQLDatastoreV1Read(ctx, d, meta)
+	return append(diags, resourceDBaaSPostgreSQLDatastoreV1Read(ctx, d, meta)...)
 }
 
 func resourceDBaaSPostgreSQLDatastoreV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -385,6 +424,7 @@ func resourceDBaaSPostgreSQLDatastoreV1ImportState(_ context.Context, d *schema.
 
 	d.Set("project_id", config.ProjectID)
 	d.Set("region", config.Region)
+	d.Set("config_managed_keys_only", false)
 
 	return []*schema.ResourceData{d}, nil
 }
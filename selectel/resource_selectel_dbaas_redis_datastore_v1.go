@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,6 +26,11 @@ func resourceDBaaSRedisDatastoreV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDBaaSRedisDatastoreV1ImportState,
 		},
+		CustomizeDiff: customdiff.All(
+			dbaasDatastoreV1ConfigManagedKeysCustomizeDiff,
+			dbaasDatastoreV1ConfigCustomizeDiff("redis"),
+			dbaasPasswordCustomizeDiff("redis_password"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -61,9 +67,29 @@ func resourceDBaaSRedisDatastoreV1() *schema.Resource {
 				ForceNew: true,
 			},
 			"type_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"engine", "engine_version"},
+				AtLeastOneOf:  []string{"type_id", "engine_version"},
+			},
+			"engine": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"type_id"},
+				ValidateFunc:  validation.StringInSlice([]string{"redis"}, false),
+			},
+			"engine_version": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"type_id"},
+				AtLeastOneOf:     []string{"type_id", "engine_version"},
+				DiffSuppressFunc: dbaasDatastoreV1EngineVersionDiffSuppressFunc,
 			},
 			"flavor_id": {
 				Type:     schema.TypeString,
@@ -147,19 +173,40 @@ func resourceDBaaSRedisDatastoreV1() *schema.Resource {
 				},
 			},
 			"config": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Computed: true,
-				ForceNew: false,
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         false,
+				DiffSuppressFunc: dbaasDatastoreV1ConfigDiffSuppressFunc,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"config_managed_keys_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"redis_password": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				ForceNew: false,
+			},
+			"generate_password": {
+				Type:     schema.TypeBool,
+				Optional: true,
 				ForceNew: false,
 			},
+			"rotation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"generated_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -172,7 +219,10 @@ func resourceDBaaSRedisDatastoreV1Create(ctx context.Context, d *schema.Resource
 
 	flavorID, flavorIDOk := d.GetOk("flavor_id")
 
-	typeID := d.Get("type_id").(string)
+	typeID, err := getDatastoreTypeID(ctx, d, dbaasClient, "redis")
+	if err != nil {
+		return diag.FromErr(errCreatingObject(objectDatastore, err))
+	}
 	diagErr = validateDatastoreType(ctx, "redis", typeID, dbaasClient)
 	if diagErr != nil {
 		return diagErr
@@ -190,17 +240,23 @@ func resourceDBaaSRedisDatastoreV1Create(ctx context.Context, d *schema.Resource
 		SubnetID:  d.Get("subnet_id").(string),
 		NodeCount: d.Get("node_count").(int),
 		Restore:   restore,
-		Config:    d.Get("config").(map[string]interface{}),
 	}
 
 	if flavorIDOk {
 		datastoreCreateOpts.FlavorID = flavorID.(string)
 	}
 
-	redisPassword, redisPasswordOk := d.GetOk("redis_password")
-	if redisPasswordOk {
-		datastoreCreateOpts.RedisPassword = redisPassword.(string)
+	redisPassword, err := getDBaaSPassword(d, "redis_password")
+	if err != nil {
+		return diag.FromErr(errCreatingObject(objectDatastore, err))
+	}
+	datastoreCreateOpts.RedisPassword = redisPassword
+
+	config, err := convertDatastoreConfigByType(ctx, dbaasClient, typeID, d.Get("config").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(errCreatingObject(objectDatastore, err))
 	}
+	datastoreCreateOpts.Config = config
 
 	log.Print(msgCreate(objectDatastore, datastoreCreateOpts))
 	datastore, err := dbaasClient.CreateDatastore(ctx, datastoreCreateOpts)
@@ -236,6 +292,7 @@ func resourceDBaaSRedisDatastoreV1Read(ctx context.Context, d *schema.ResourceDa
 	d.Set("project_id", datastore.ProjectID)
 	d.Set("subnet_id", datastore.SubnetID)
 	d.Set("type_id", datastore.TypeID)
+	setDatastoreTypeEngine(ctx, d, dbaasClient, datastore.TypeID)
 	d.Set("node_count", datastore.NodeCount)
 	d.Set("enabled", datastore.Enabled)
 	d.Set("flavor_id", datastore.FlavorID)
@@ -249,10 +306,7 @@ func resourceDBaaSRedisDatastoreV1Read(ctx context.Context, d *schema.ResourceDa
 		log.Print(errSettingComplexAttr("connections", err))
 	}
 
-	configMap := make(map[string]string)
-	for key, value := range datastore.Config {
-		configMap[key] = convertFieldToStringByType(value)
-	}
+	configMap := flattenDatastoreConfig(d, datastore.Config)
 	if err := d.Set("config", configMap); err != nil {
 		log.Print(errSettingComplexAttr("config", err))
 	}
@@ -279,25 +333,31 @@ func resourceDBaaSRedisDatastoreV1Update(ctx context.Context, d *schema.Resource
 		}
 	}
 	if d.HasChange("node_count") || d.HasChange("flavor_id") {
-		err := resizeRedisDatastore(ctx, d, dbaasClient)
+		err := resizeDatastoreByFlavorID(ctx, d, dbaasClient)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
+	var diags diag.Diagnostics
 	if d.HasChange("config") {
 		err := updateDatastoreConfig(ctx, d, dbaasClient)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		diags = dbaasDatastoreV1ConfigRestartWarning(ctx, d, dbaasClient)
 	}
-	if d.HasChange("redis_password") {
-		err := updateRedisDatastorePassword(ctx, d, dbaasClient)
+	if dbaasPasswordHasChange(d, "redis_password") {
+		redisPassword, err := getDBaaSPassword(d, "redis_password")
+		if err != nil {
+			return diag.FromErr(errUpdatingObject(objectDatastore, d.Id(), err))
+		}
+		err = updateRedisDatastorePassword(ctx, d, dbaasClient, redisPassword)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	return resourceDBaaSRedisDatastoreV1Read(ctx, d, meta)
+	return append(diags, resourceDBaaSRedisDatastoreV1Read(ctx, d, meta)...)
 }
 
 func resourceDBaaSRedisDatastoreV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -341,6 +401,7 @@ func resourceDBaaSRedisDatastoreV1ImportState(_ context.Context, d *schema.Resou
 
 	d.Set("project_id", config.ProjectID)
 	d.Set("region", config.Region)
+	d.Set("config_managed_keys_only", false)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -25,6 +25,7 @@ func resourceDBaaSUserV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDBaaSUserV1ImportState,
 		},
+		CustomizeDiff: dbaasPasswordCustomizeDiff("password"),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -57,9 +58,24 @@ func resourceDBaaSUserV1() *schema.Resource {
 			},
 			"password": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				ForceNew: false,
+			},
+			"generate_password": {
+				Type:     schema.TypeBool,
+				Optional: true,
 				ForceNew: false,
 			},
+			"rotation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"generated_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -84,10 +100,15 @@ func resourceDBaaSUserV1Create(ctx context.Context, d *schema.ResourceData, meta
 		return diagErr
 	}
 
+	password, err := getDBaaSPassword(d, "password")
+	if err != nil {
+		return diag.FromErr(errCreatingObject(objectUser, err))
+	}
+
 	userCreateOpts := dbaas.UserCreateOpts{
 		DatastoreID: datastoreID,
 		Name:        d.Get("name").(string),
-		Password:    d.Get("password").(string),
+		Password:    password,
 	}
 
 	log.Print(msgCreate(objectUser, userCreateOpts))
@@ -137,14 +158,17 @@ func resourceDBaaSUserV1Update(ctx context.Context, d *schema.ResourceData, meta
 		return diagErr
 	}
 
-	if d.HasChange("password") {
-		password := d.Get("password").(string)
+	if dbaasPasswordHasChange(d, "password") {
+		password, err := getDBaaSPassword(d, "password")
+		if err != nil {
+			return diag.FromErr(errUpdatingObject(objectUser, d.Id(), err))
+		}
 		updateOpts := dbaas.UserUpdateOpts{
 			Password: password,
 		}
 
 		log.Print(msgUpdate(objectUser, d.Id(), updateOpts))
-		_, err := dbaasClient.UpdateUser(ctx, d.Id(), updateOpts)
+		_, err = dbaasClient.UpdateUser(ctx, d.Id(), updateOpts)
 		if err != nil {
 			return diag.FromErr(errUpdatingObject(objectUser, d.Id(), err))
 		}
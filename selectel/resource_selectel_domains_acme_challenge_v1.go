@@ -0,0 +1,264 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v1 "github.com/selectel/domains-go/pkg/v1"
+	"github.com/selectel/domains-go/pkg/v1/domain"
+	"github.com/selectel/domains-go/pkg/v1/record"
+)
+
+const (
+	domainsV1ACMEChallengeStatePending    = "PENDING"
+	domainsV1ACMEChallengeStatePropagated = "PROPAGATED"
+)
+
+func resourceDomainsACMEChallengeV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainsACMEChallengeV1Create,
+		ReadContext:   resourceDomainsACMEChallengeV1Read,
+		UpdateContext: resourceDomainsACMEChallengeV1Update,
+		DeleteContext: resourceDomainsACMEChallengeV1Delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"digest": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(60, 604800),
+			},
+			"nameservers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"polling_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDomainsACMEChallengeV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client := config.domainsV1Client()
+
+	fqdn := d.Get("fqdn").(string)
+	name := domainsV1ACMEChallengeName(fqdn)
+
+	domainObj, err := domainsV1ACMEChallengeDomain(ctx, d, client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameservers []string
+	for _, nameserver := range d.Get("nameservers").([]interface{}) {
+		nameservers = append(nameservers, nameserver.(string))
+	}
+	if len(nameservers) == 0 {
+		nameservers, err = domainsV1ListApexNameservers(ctx, client, domainObj)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	createOpts := &record.CreateOpts{
+		Name:    name,
+		Type:    record.Type(TypeRecordTXT),
+		TTL:     d.Get("ttl").(int),
+		Content: d.Get("digest").(string),
+	}
+	recordObj, err := domainsV1CreateRecord(ctx, client, domainObj.ID, createOpts)
+	if err != nil {
+		return diag.FromErr(errCreatingObject(objectACMEChallenge, err))
+	}
+
+	// The ID is set before waiting, so the record is cleaned up
+	// if it doesn't propagate in time.
+	d.SetId(fmt.Sprintf("%d/%d", domainObj.ID, recordObj.ID))
+
+	log.Printf("[DEBUG] Waiting for the %s '%s' to propagate to %v", objectACMEChallenge, name, nameservers)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	pollInterval := time.Duration(d.Get("polling_interval").(int)) * time.Second
+	err = waitForDomainsV1ACMEChallengePropagated(ctx, nameservers, name, createOpts.Content, pollInterval, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDomainsACMEChallengeV1Read(ctx, d, meta)
+}
+
+func resourceDomainsACMEChallengeV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client := config.domainsV1Client()
+
+	domainID, recordID, err := domainsV1ParseDomainRecordIDsPair(d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(errGettingObject(objectACMEChallenge, d.Id(), err))
+	}
+
+	log.Print(msgGet(objectACMEChallenge, d.Id()))
+
+	recordObj, resp, err := record.Get(ctx, client, domainID, recordID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(errGettingObject(objectACMEChallenge, d.Id(), err))
+	}
+
+	d.Set("domain_id", domainID)
+	d.Set("name", recordObj.Name)
+	d.Set("ttl", recordObj.TTL)
+	d.Set("digest", recordObj.Content)
+
+	return nil
+}
+
+// resourceDomainsACMEChallengeV1Update only stores the new polling settings,
+// they are used when the challenge is created.
+func resourceDomainsACMEChallengeV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDomainsACMEChallengeV1Read(ctx, d, meta)
+}
+
+func resourceDomainsACMEChallengeV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	domainID, recordID, err := domainsV1ParseDomainRecordIDsPair(d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(errGettingObject(objectACMEChallenge, d.Id(), err))
+	}
+
+	config := meta.(*Config)
+	client := config.domainsV1Client()
+
+	resp, err := domainsV1DeleteRecord(ctx, client, domainID, recordID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+
+		return diag.FromErr(errDeletingObject(objectACMEChallenge, d.Id(), err))
+	}
+
+	return nil
+}
+
+// domainsV1ACMEChallengeDomain returns the domain set in the domain_id argument or
+// the domain that the challenge record name belongs to.
+func domainsV1ACMEChallengeDomain(ctx context.Context, d *schema.ResourceData, client *v1.ServiceClient, name string) (*domain.View, error) {
+	if domainID, ok := d.GetOk("domain_id"); ok {
+		log.Print(msgGet(objectDomain, strconv.Itoa(domainID.(int))))
+		domainObj, _, err := domain.GetByID(ctx, client, domainID.(int))
+		if err != nil {
+			return nil, errGettingObject(objectDomain, strconv.Itoa(domainID.(int)), err)
+		}
+
+		return domainObj, nil
+	}
+
+	domains, _, err := domain.List(ctx, client)
+	if err != nil {
+		return nil, errGettingObjects(objectDomain, err)
+	}
+
+	domainObj := domainsV1MatchDomain(domains, name)
+	if domainObj == nil {
+		return nil, errGettingObject(objectDomain, name, fmt.Errorf("no domain found for '%s'", d.Get("fqdn").(string)))
+	}
+
+	return domainObj, nil
+}
+
+// domainsV1ListApexNameservers returns the authoritative nameservers set in the NS records
+// at the apex of the domain.
+func domainsV1ListApexNameservers(ctx context.Context, client *v1.ServiceClient, domainObj *domain.View) ([]string, error) {
+	records, err := domainsV1ListRRSetRecords(ctx, client, domainObj.ID, domainObj.Name, TypeRecordNS)
+	if err != nil {
+		return nil, errGettingObjects(objectRecord, err)
+	}
+	if len(records) == 0 {
+		return nil, errGettingObjects(objectRecord, fmt.Errorf("domain '%s' has no NS records, set nameservers explicitly", domainObj.Name))
+	}
+
+	nameservers := make([]string, 0, len(records))
+	for _, r := range records {
+		nameservers = append(nameservers, r.Content)
+	}
+
+	return nameservers, nil
+}
+
+func waitForDomainsV1ACMEChallengePropagated(
+	ctx context.Context, nameservers []string, name, digest string, pollInterval, timeout time.Duration,
+) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{domainsV1ACMEChallengeStatePending},
+		Target:       []string{domainsV1ACMEChallengeStatePropagated},
+		Refresh:      domainsV1ACMEChallengeStateRefreshFunc(ctx, nameservers, name, digest),
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf(
+			"error waiting for the %s '%s' to propagate: %s",
+			objectACMEChallenge, name, err)
+	}
+
+	return nil
+}
+
+func domainsV1ACMEChallengeStateRefreshFunc(ctx context.Context, nameservers []string, name, digest string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		for _, nameserver := range nameservers {
+			values, err := domainsV1LookupTXT(ctx, domainsV1NameserverAddress(nameserver), name)
+			if err != nil || !containsString(values, digest) {
+				log.Printf("[DEBUG] %s '%s' isn't served by %s yet: %v", objectACMEChallenge, name, nameserver, err)
+				return values, domainsV1ACMEChallengeStatePending, nil
+			}
+		}
+
+		return name, domainsV1ACMEChallengeStatePropagated, nil
+	}
+}
@@ -0,0 +1,56 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/selectel/domains-go/pkg/v1/domain"
+	"github.com/selectel/domains-go/pkg/v1/record"
+)
+
+func TestAccDomainsACMEChallengeV1Basic(t *testing.T) {
+	var (
+		testDomain domain.View
+		testRecord record.View
+	)
+
+	testDomainName := fmt.Sprintf("%s.xyz", acctest.RandomWithPrefix("tf-acc"))
+	testFQDN := fmt.Sprintf("*.%s", testDomainName)
+	testDigest := acctest.RandString(43)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV1DomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsACMEChallengeV1Basic(testDomainName, testFQDN, testDigest),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainsDomainV1Exists("selectel_domains_domain_v1.domain_tf_acc_test_1", &testDomain),
+					testAccCheckDomainsRecordV1Exists("selectel_domains_acme_challenge_v1.challenge_tf_acc_test_1", &testRecord),
+					resource.TestCheckResourceAttr("selectel_domains_acme_challenge_v1.challenge_tf_acc_test_1", "name",
+						fmt.Sprintf("_acme-challenge.%s", testDomainName)),
+					resource.TestCheckResourceAttr("selectel_domains_acme_challenge_v1.challenge_tf_acc_test_1", "digest", testDigest),
+					resource.TestCheckResourceAttrPair("selectel_domains_acme_challenge_v1.challenge_tf_acc_test_1", "domain_id",
+						"selectel_domains_domain_v1.domain_tf_acc_test_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsACMEChallengeV1Basic(domainName, fqdn, digest string) string {
+	return fmt.Sprintf(`
+resource "selectel_domains_domain_v1" "domain_tf_acc_test_1" {
+  name = "%s"
+}
+
+resource "selectel_domains_acme_challenge_v1" "challenge_tf_acc_test_1" {
+  domain_id = selectel_domains_domain_v1.domain_tf_acc_test_1.id
+  fqdn      = "%s"
+  digest    = "%s"
+}
+`, domainName, fqdn, digest)
+}
@@ -7,10 +7,13 @@ import (
 	"net/http"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/selectel/domains-go/pkg/v1/domain"
 	"github.com/selectel/domains-go/pkg/v1/record"
 )
 
@@ -22,8 +25,12 @@ func resourceDomainsRecordV1() *schema.Resource {
 		UpdateContext: resourceDomainsRecordV1Update,
 		DeleteContext: resourceDomainsRecordV1Delete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDomainsRecordV1ImportState,
 		},
+		CustomizeDiff: customdiff.All(
+			resourceDomainsRecordV1TypeCustomizeDiff,
+			resourceDomainsRecordV1ValidateCustomizeDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"domain_id": {
 				Type:     schema.TypeInt,
@@ -37,7 +44,6 @@ func resourceDomainsRecordV1() *schema.Resource {
 			"type": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					TypeRecordA,
 					TypeRecordAAAA,
@@ -58,8 +64,9 @@ func resourceDomainsRecordV1() *schema.Resource {
 				ValidateFunc: validation.IntBetween(60, 604800),
 			},
 			"content": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: domainsV1RecordContentDiffSuppressFunc,
 			},
 			"email": {
 				Type:     schema.TypeString,
@@ -128,8 +135,6 @@ func resourceDomainsRecordV1() *schema.Resource {
 
 func resourceDomainsRecordV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	domainID := d.Get("domain_id").(int)
-	selMutexKV.Lock(strconv.Itoa(domainID))
-	defer selMutexKV.Unlock(strconv.Itoa(domainID))
 
 	config := meta.(*Config)
 	client := config.domainsV1Client()
@@ -152,8 +157,11 @@ func resourceDomainsRecordV1Create(ctx context.Context, d *schema.ResourceData,
 		Fingerprint:     d.Get("fingerprint").(string),
 	}
 
-	log.Print(msgCreate(objectRecord, createOpts))
-	recordObj, _, err := record.Create(ctx, client, domainID, createOpts)
+	if string(createOpts.Type) == TypeRecordTXT {
+		createOpts.Content = domainsV1SplitTXTContent(createOpts.Content)
+	}
+
+	recordObj, err := domainsV1CreateRecord(ctx, client, domainID, createOpts)
 	if err != nil {
 		return diag.FromErr(errCreatingObject(objectRecord, err))
 	}
@@ -221,7 +229,7 @@ func resourceDomainsRecordV1Update(ctx context.Context, d *schema.ResourceData,
 	config := meta.(*Config)
 	client := config.domainsV1Client()
 
-	if d.HasChanges("name", "content", "email", "ttl", "priority", "weight", "port", "target", "tag", "flag", "value", "algorithm", "fingerprint_type", "fingerprint") {
+	if d.HasChanges("name", "type", "content", "email", "ttl", "priority", "weight", "port", "target", "tag", "flag", "value", "algorithm", "fingerprint_type", "fingerprint") {
 		updateOpts := &record.UpdateOpts{
 			Name:            d.Get("name").(string),
 			Type:            record.Type(d.Get("type").(string)),
@@ -239,6 +247,10 @@ func resourceDomainsRecordV1Update(ctx context.Context, d *schema.ResourceData,
 			FingerprintType: getIntPtrOrNil(d.Get("fingerprint_type")),
 			Fingerprint:     d.Get("fingerprint").(string),
 		}
+		if string(updateOpts.Type) == TypeRecordTXT {
+			updateOpts.Content = domainsV1SplitTXTContent(updateOpts.Content)
+		}
+
 		_, _, err = record.Update(ctx, client, domainID, recordID, updateOpts)
 		if err != nil {
 			return diag.FromErr(errUpdatingObject(objectRecord, d.Id(), err))
@@ -254,18 +266,126 @@ func resourceDomainsRecordV1Delete(ctx context.Context, d *schema.ResourceData,
 		d.SetId("")
 		return diag.FromErr(errGettingObject(objectRecord, d.Id(), err))
 	}
-	selMutexKV.Lock(strconv.Itoa(domainID))
-	defer selMutexKV.Unlock(strconv.Itoa(domainID))
 
 	config := meta.(*Config)
 	client := config.domainsV1Client()
 
-	log.Print(msgDelete(objectRecord, d.Id()))
-
-	_, err = record.Delete(ctx, client, domainID, recordID)
+	_, err = domainsV1DeleteRecord(ctx, client, domainID, recordID)
 	if err != nil {
 		return diag.FromErr(errDeletingObject(objectRecord, d.Id(), err))
 	}
 
 	return nil
 }
+
+func resourceDomainsRecordV1ImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if domainID, _, err := domainsV1ParseDomainRecordIDsPair(d.Id()); err == nil {
+		d.Set("domain_id", domainID)
+		return []*schema.ResourceData{d}, nil
+	}
+
+	// The ID can also be set as domain_name/record_name/type.
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, errParseDomainsDomainRecordV1IDsPair(d.Id())
+	}
+	domainName, recordName, recordType := parts[0], parts[1], strings.ToUpper(parts[2])
+
+	config := meta.(*Config)
+	client := config.domainsV1Client()
+
+	log.Print(msgGet(objectDomain, domainName))
+	domainObj, _, err := domain.GetByName(ctx, client, domainName)
+	if err != nil {
+		return nil, errGettingObject(objectDomain, domainName, err)
+	}
+
+	records, err := domainsV1ListRRSetRecords(ctx, client, domainObj.ID, recordName, recordType)
+	if err != nil {
+		return nil, errGettingObject(objectRecord, d.Id(), err)
+	}
+	if len(records) != 1 {
+		return nil, errGettingObject(objectRecord, d.Id(),
+			fmt.Errorf("expected a single record, found %d, use the domain_id/record_id format", len(records)))
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d", domainObj.ID, records[0].ID))
+	d.Set("domain_id", domainObj.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceDomainsRecordV1TypeCustomizeDiff forces replacement of the record only when
+// the type changes between types with different fields. Records of the types that
+// only have content are updated in place.
+func resourceDomainsRecordV1TypeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("type") {
+		return nil
+	}
+
+	oldType, newType := d.GetChange("type")
+	if domainsV1RecordContentOnlyTypes[oldType.(string)] && domainsV1RecordContentOnlyTypes[newType.(string)] {
+		return nil
+	}
+
+	return d.ForceNew("type")
+}
+
+// resourceDomainsRecordV1ValidateCustomizeDiff validates the record data for the record type
+// and checks that the record doesn't conflict with other records of the domain.
+func resourceDomainsRecordV1ValidateCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{
+		"name", "type", "content", "email", "target", "tag", "value", "fingerprint_type", "fingerprint",
+	} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	opts := &record.CreateOpts{
+		Name:            d.Get("name").(string),
+		Type:            record.Type(d.Get("type").(string)),
+		Content:         d.Get("content").(string),
+		Email:           d.Get("email").(string),
+		Target:          d.Get("target").(string),
+		Tag:             d.Get("tag").(string),
+		Value:           d.Get("value").(string),
+		FingerprintType: getIntPtrOrNil(d.Get("fingerprint_type")),
+		Fingerprint:     d.Get("fingerprint").(string),
+	}
+	if err := domainsV1ValidateRecordOpts(opts); err != nil {
+		return err
+	}
+
+	// Other records are checked only when the record is placed under a new name or type
+	// of an existing domain.
+	if !d.NewValueKnown("domain_id") || (d.Id() != "" && !d.HasChanges("name", "type")) {
+		return nil
+	}
+
+	config := meta.(*Config)
+	client := config.domainsV1Client()
+
+	domainID := d.Get("domain_id").(int)
+	log.Print(msgGet(objectDomain, strconv.Itoa(domainID)))
+	domainObj, resp, err := domain.GetByID(ctx, client, domainID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+
+		return errGettingObject(objectDomain, strconv.Itoa(domainID), err)
+	}
+
+	records, _, err := record.ListByDomainID(ctx, client, domainID)
+	if err != nil {
+		return errGettingObjects(objectRecord, err)
+	}
+
+	var recordID int
+	if d.Id() != "" {
+		_, recordID, _ = domainsV1ParseDomainRecordIDsPair(d.Id())
+	}
+
+	return domainsV1ValidateRecordConflicts(domainObj.Name, recordID, opts, records)
+}
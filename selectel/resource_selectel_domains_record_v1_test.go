@@ -236,7 +236,7 @@ func TestAccDomainsRecordV1Basic(t *testing.T) {
 						"1"),
 					resource.TestCheckResourceAttr("selectel_domains_record_v1.record_sshfp_tf_acc_test_1",
 						"fingerprint",
-						"00AAFF"),
+						"123456789abcdef67890123456789abcdef67890"),
 				),
 			},
 			{
@@ -429,13 +429,65 @@ func TestAccDomainsRecordV1Basic(t *testing.T) {
 						"2"),
 					resource.TestCheckResourceAttr("selectel_domains_record_v1.record_sshfp_tf_acc_test_1",
 						"fingerprint",
-						"00BB"),
+						"b1a2c3d4e5f60718293a4b5c6d7e8f90b1a2c3d4e5f60718293a4b5c6d7e8f90"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccDomainsRecordV1UpdateType(t *testing.T) {
+	var testRecord, testUpdatedRecord record.View
+
+	testDomainName := fmt.Sprintf("%s.xyz", acctest.RandomWithPrefix("tf-acc"))
+	testRecordName := fmt.Sprintf("record.%s", testDomainName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV1DomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsRecordV1Type(testDomainName, testRecordName, "CNAME", "origin.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainsRecordV1Exists("selectel_domains_record_v1.record_tf_acc_test_1", &testRecord),
+					resource.TestCheckResourceAttr("selectel_domains_record_v1.record_tf_acc_test_1", "type", "CNAME"),
+				),
+			},
+			{
+				Config: testAccDomainsRecordV1Type(testDomainName, testRecordName, "A", "127.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainsRecordV1Exists("selectel_domains_record_v1.record_tf_acc_test_1", &testUpdatedRecord),
+					resource.TestCheckResourceAttr("selectel_domains_record_v1.record_tf_acc_test_1", "type", "A"),
+					resource.TestCheckResourceAttr("selectel_domains_record_v1.record_tf_acc_test_1", "content", "127.0.0.1"),
+					func(_ *terraform.State) error {
+						if testRecord.ID != testUpdatedRecord.ID {
+							return errors.New("record was recreated on the type change")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsRecordV1Type(domainName, recordName, recordType, content string) string {
+	return fmt.Sprintf(`
+resource "selectel_domains_domain_v1" "domain_tf_acc_test_1" {
+  name = "%s"
+}
+
+resource "selectel_domains_record_v1" "record_tf_acc_test_1" {
+  domain_id = selectel_domains_domain_v1.domain_tf_acc_test_1.id
+  name = "%s"
+  type = "%s"
+  content = "%s"
+  ttl  = 60
+}
+`, domainName, recordName, recordType, content)
+}
+
 func testAccDomainsRecordV1Basic(
 	domainName,
 	recordNameA,
@@ -538,7 +590,7 @@ resource "selectel_domains_record_v1" "record_sshfp_tf_acc_test_1" {
 	ttl = 60
 	algorithm = 1
 	fingerprint_type = 1
-	fingerprint = "00AAFF"
+	fingerprint = "123456789abcdef67890123456789abcdef67890"
 }
 `,
 		domainName,
@@ -657,7 +709,7 @@ resource "selectel_domains_record_v1" "record_sshfp_tf_acc_test_1" {
 	ttl = 120
 	algorithm = 2
 	fingerprint_type = 2
-	fingerprint = "00BB"
+	fingerprint = "b1a2c3d4e5f60718293a4b5c6d7e8f90b1a2c3d4e5f60718293a4b5c6d7e8f90"
 }
 `,
 		domainName,
@@ -0,0 +1,314 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v1 "github.com/selectel/domains-go/pkg/v1"
+	"github.com/selectel/domains-go/pkg/v1/record"
+)
+
+func resourceDomainsRRSetV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainsRRSetV1Create,
+		ReadContext:   resourceDomainsRRSetV1Read,
+		UpdateContext: resourceDomainsRRSetV1Update,
+		DeleteContext: resourceDomainsRRSetV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceDomainsRRSetV1CustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return domainsV1RecordNamesEqual(old, new)
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					TypeRecordA,
+					TypeRecordAAAA,
+					TypeRecordTXT,
+					TypeRecordCNAME,
+					TypeRecordNS,
+					TypeRecordMX,
+					TypeRecordSRV,
+					TypeRecordCAA,
+					TypeRecordSSHFP,
+					TypeRecordALIAS,
+				}, false),
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(60, 604800),
+			},
+			"records": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDomainsRRSetV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	domainID := d.Get("domain_id").(int)
+	selMutexKV.Lock(strconv.Itoa(domainID))
+	defer selMutexKV.Unlock(strconv.Itoa(domainID))
+
+	config := meta.(*Config)
+	client := config.domainsV1Client()
+
+	name := d.Get("name").(string)
+	recordType := d.Get("type").(string)
+	values := expandDomainsV1RRSetRecords(d.Get("records").(*schema.Set))
+
+	err := domainsV1ReconcileRRSet(ctx, client, domainID, name, recordType, d.Get("ttl").(int), values)
+	if err != nil {
+		return diag.FromErr(errCreatingObject(objectRRSet, err))
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s/%s", domainID, name, recordType))
+
+	return resourceDomainsRRSetV1Read(ctx, d, meta)
+}
+
+func resourceDomainsRRSetV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client := config.domainsV1Client()
+
+	domainID, name, recordType, err := domainsV1ParseRRSetID(d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(errGettingObject(objectRRSet, d.Id(), err))
+	}
+
+	log.Print(msgGet(objectRRSet, d.Id()))
+
+	records, err := domainsV1ListRRSetRecords(ctx, client, domainID, name, recordType)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectRRSet, d.Id(), err))
+	}
+	if len(records) == 0 {
+		log.Printf("[DEBUG] rrset %s has no records, removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Records added outside of Terraform are part of the set
+	// and show up as a drift.
+	ttl := d.Get("ttl").(int)
+	values := make([]string, 0, len(records))
+	for _, r := range records {
+		if r.TTL != ttl {
+			ttl = r.TTL
+		}
+		values = append(values, domainsV1RecordRData(r))
+	}
+
+	d.Set("domain_id", domainID)
+	d.Set("type", recordType)
+	if !domainsV1RecordNamesEqual(d.Get("name").(string), name) {
+		d.Set("name", name)
+	}
+	d.Set("ttl", ttl)
+	if err := d.Set("records", values); err != nil {
+		log.Print(errSettingComplexAttr("records", err))
+	}
+
+	return nil
+}
+
+func resourceDomainsRRSetV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	domainID, name, recordType, err := domainsV1ParseRRSetID(d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(errGettingObject(objectRRSet, d.Id(), err))
+	}
+	selMutexKV.Lock(strconv.Itoa(domainID))
+	defer selMutexKV.Unlock(strconv.Itoa(domainID))
+
+	config := meta.(*Config)
+	client := config.domainsV1Client()
+
+	if d.HasChanges("ttl", "records") {
+		values := expandDomainsV1RRSetRecords(d.Get("records").(*schema.Set))
+		err := domainsV1ReconcileRRSet(ctx, client, domainID, name, recordType, d.Get("ttl").(int), values)
+		if err != nil {
+			return diag.FromErr(errUpdatingObject(objectRRSet, d.Id(), err))
+		}
+	}
+
+	return resourceDomainsRRSetV1Read(ctx, d, meta)
+}
+
+func resourceDomainsRRSetV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	domainID, name, recordType, err := domainsV1ParseRRSetID(d.Id())
+	if err != nil {
+		d.SetId("")
+		return diag.FromErr(errGettingObject(objectRRSet, d.Id(), err))
+	}
+	selMutexKV.Lock(strconv.Itoa(domainID))
+	defer selMutexKV.Unlock(strconv.Itoa(domainID))
+
+	config := meta.(*Config)
+	client := config.domainsV1Client()
+
+	records, err := domainsV1ListRRSetRecords(ctx, client, domainID, name, recordType)
+	if err != nil {
+		return diag.FromErr(errDeletingObject(objectRRSet, d.Id(), err))
+	}
+
+	for _, r := range records {
+		log.Print(msgDelete(objectRecord, strconv.Itoa(r.ID)))
+		_, err := record.Delete(ctx, client, domainID, r.ID)
+		if err != nil {
+			return diag.FromErr(errDeletingObject(objectRRSet, d.Id(), err))
+		}
+	}
+
+	return nil
+}
+
+func resourceDomainsRRSetV1CustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("records") {
+		return nil
+	}
+
+	recordType := d.Get("type").(string)
+	for _, value := range expandDomainsV1RRSetRecords(d.Get("records").(*schema.Set)) {
+		opts, err := domainsV1RecordOptsFromRData(recordType, value)
+		if err != nil {
+			return err
+		}
+		if err := domainsV1ValidateRecordOpts(opts); err != nil {
+			return err
+		}
+		// Values are compared with the values returned by the API,
+		// so they must be written in the same form.
+		if canonical := domainsV1RecordRData(domainsV1RecordViewFromOpts(opts)); canonical != value {
+			return fmt.Errorf("%s record data %q must be written as %q", recordType, value, canonical)
+		}
+	}
+
+	return nil
+}
+
+// domainsV1ListRRSetRecords returns records of the domain with the name and the type.
+func domainsV1ListRRSetRecords(ctx context.Context, client *v1.ServiceClient, domainID int, name, recordType string) ([]*record.View, error) {
+	records, _, err := record.ListByDomainID(ctx, client, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rrset []*record.View
+	for _, r := range records {
+		if string(r.Type) == recordType && domainsV1RecordNamesEqual(r.Name, name) {
+			rrset = append(rrset, r)
+		}
+	}
+
+	return rrset, nil
+}
+
+// domainsV1ReconcileRRSet makes records of the domain with the name and the type
+// match the values. Records that already have one of the values are kept, other
+// records are updated to the missing values or deleted.
+func domainsV1ReconcileRRSet(ctx context.Context, client *v1.ServiceClient, domainID int, name, recordType string, ttl int, values []string) error {
+	records, err := domainsV1ListRRSetRecords(ctx, client, domainID, name, recordType)
+	if err != nil {
+		return err
+	}
+
+	missing := make(map[string]bool, len(values))
+	for _, value := range values {
+		missing[value] = true
+	}
+
+	var stale []*record.View
+	for _, r := range records {
+		rdata := domainsV1RecordRData(r)
+		if !missing[rdata] {
+			stale = append(stale, r)
+			continue
+		}
+		delete(missing, rdata)
+		if r.TTL != ttl {
+			if err := domainsV1UpdateRRSetRecord(ctx, client, domainID, r.ID, name, recordType, ttl, rdata); err != nil {
+				return err
+			}
+		}
+	}
+
+	missingValues := make([]string, 0, len(missing))
+	for value := range missing {
+		missingValues = append(missingValues, value)
+	}
+	sort.Strings(missingValues)
+
+	for i, value := range missingValues {
+		if i < len(stale) {
+			if err := domainsV1UpdateRRSetRecord(ctx, client, domainID, stale[i].ID, name, recordType, ttl, value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		opts, err := domainsV1RecordOptsFromRData(recordType, value)
+		if err != nil {
+			return err
+		}
+		opts.Name = name
+		opts.TTL = ttl
+
+		log.Print(msgCreate(objectRecord, opts))
+		if _, _, err := record.Create(ctx, client, domainID, opts); err != nil {
+			return err
+		}
+	}
+
+	for i := len(missingValues); i < len(stale); i++ {
+		log.Print(msgDelete(objectRecord, strconv.Itoa(stale[i].ID)))
+		if _, err := record.Delete(ctx, client, domainID, stale[i].ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func domainsV1UpdateRRSetRecord(ctx context.Context, client *v1.ServiceClient, domainID, recordID int, name, recordType string, ttl int, rdata string) error {
+	opts, err := domainsV1RecordOptsFromRData(recordType, rdata)
+	if err != nil {
+		return err
+	}
+	opts.Name = name
+	opts.TTL = ttl
+	updateOpts := record.UpdateOpts(*opts)
+
+	log.Print(msgUpdate(objectRecord, strconv.Itoa(recordID), updateOpts))
+	_, _, err = record.Update(ctx, client, domainID, recordID, &updateOpts)
+
+	return err
+}
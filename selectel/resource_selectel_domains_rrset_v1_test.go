@@ -0,0 +1,128 @@
+package selectel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/selectel/domains-go/pkg/v1/domain"
+	"github.com/selectel/domains-go/pkg/v1/record"
+)
+
+func TestAccDomainsRRSetV1Basic(t *testing.T) {
+	var (
+		testDomain  domain.View
+		testRecords []*record.View
+	)
+
+	testDomainName := fmt.Sprintf("%s.xyz", acctest.RandomWithPrefix("tf-acc"))
+	testRRSetName := fmt.Sprintf("www.%s", testDomainName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV1DomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsRRSetV1Basic(testDomainName, testRRSetName, 60, `"127.0.0.1", "127.0.0.2"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainsDomainV1Exists("selectel_domains_domain_v1.domain_tf_acc_test_1", &testDomain),
+					testAccCheckDomainsRRSetV1Exists("selectel_domains_rrset_v1.rrset_tf_acc_test_1", &testRecords),
+					resource.TestCheckResourceAttr("selectel_domains_rrset_v1.rrset_tf_acc_test_1", "name", testRRSetName),
+					resource.TestCheckResourceAttr("selectel_domains_rrset_v1.rrset_tf_acc_test_1", "type", "A"),
+					resource.TestCheckResourceAttr("selectel_domains_rrset_v1.rrset_tf_acc_test_1", "ttl", "60"),
+					resource.TestCheckResourceAttr("selectel_domains_rrset_v1.rrset_tf_acc_test_1", "records.#", "2"),
+					resource.TestCheckTypeSetElemAttr("selectel_domains_rrset_v1.rrset_tf_acc_test_1", "records.*", "127.0.0.1"),
+					resource.TestCheckTypeSetElemAttr("selectel_domains_rrset_v1.rrset_tf_acc_test_1", "records.*", "127.0.0.2"),
+				),
+			},
+			{
+				Config: testAccDomainsRRSetV1Basic(testDomainName, testRRSetName, 120, `"127.0.0.2", "127.0.0.3", "127.0.0.4"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainsRRSetV1Exists("selectel_domains_rrset_v1.rrset_tf_acc_test_1", &testRecords),
+					resource.TestCheckResourceAttr("selectel_domains_rrset_v1.rrset_tf_acc_test_1", "ttl", "120"),
+					resource.TestCheckResourceAttr("selectel_domains_rrset_v1.rrset_tf_acc_test_1", "records.#", "3"),
+					resource.TestCheckTypeSetElemAttr("selectel_domains_rrset_v1.rrset_tf_acc_test_1", "records.*", "127.0.0.2"),
+					resource.TestCheckTypeSetElemAttr("selectel_domains_rrset_v1.rrset_tf_acc_test_1", "records.*", "127.0.0.3"),
+					resource.TestCheckTypeSetElemAttr("selectel_domains_rrset_v1.rrset_tf_acc_test_1", "records.*", "127.0.0.4"),
+				),
+			},
+			{
+				Config: testAccDomainsRRSetV1Basic(testDomainName, testRRSetName, 120, `"127.0.0.2", "127.0.0.3", "127.0.0.4"`),
+				PreConfig: func() {
+					testAccDomainsRRSetV1CreateUnmanagedRecord(t, &testDomain, testRRSetName, "127.0.0.5")
+				},
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDomainsRRSetV1Exists(n string, records *[]*record.View) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("no ID is set")
+		}
+
+		domainID, name, recordType, err := domainsV1ParseRRSetID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client := config.domainsV1Client()
+		ctx := context.Background()
+
+		foundRecords, err := domainsV1ListRRSetRecords(ctx, client, domainID, name, recordType)
+		if err != nil {
+			return err
+		}
+		if len(foundRecords) == 0 {
+			return errors.New("rrset not found")
+		}
+
+		*records = foundRecords
+
+		return nil
+	}
+}
+
+func testAccDomainsRRSetV1CreateUnmanagedRecord(t *testing.T, testDomain *domain.View, name, content string) {
+	config := testAccProvider.Meta().(*Config)
+	client := config.domainsV1Client()
+	ctx := context.Background()
+
+	createOpts := &record.CreateOpts{
+		Name:    name,
+		Type:    record.TypeA,
+		TTL:     120,
+		Content: content,
+	}
+	if _, _, err := record.Create(ctx, client, testDomain.ID, createOpts); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDomainsRRSetV1Basic(domainName, rrsetName string, ttl int, records string) string {
+	return fmt.Sprintf(`
+resource "selectel_domains_domain_v1" "domain_tf_acc_test_1" {
+  name = "%s"
+}
+
+resource "selectel_domains_rrset_v1" "rrset_tf_acc_test_1" {
+  domain_id = "${selectel_domains_domain_v1.domain_tf_acc_test_1.id}"
+  name = "%s"
+  type = "A"
+  ttl = %d
+  records = [%s]
+}`, domainName, rrsetName, ttl, records)
+}